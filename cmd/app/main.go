@@ -1,11 +1,22 @@
 package main
 
 import (
+	"context"
 	"log"
+	"net"
 	"net/http"
+	"todo-api-golang/edge/database"
+	"todo-api-golang/edge/token"
+	"todo-api-golang/internal/grpcserver"
 	"todo-api-golang/internal/routes"
+	"todo-api-golang/internal/service"
+	"todo-api-golang/internal/worker"
+	"todo-api-golang/internal/workflow"
+	response "todo-api-golang/middleware"
 	"todo-api-golang/util"
 
+	"github.com/go-playground/validator/v10"
+
 	_ "todo-api-golang/docs" // Swagger docs 패키지 임포트
 )
 
@@ -15,7 +26,10 @@ import (
 // @host localhost:8000
 // @BasePath /
 
-// @securityDefinitions.basic BasicAuth
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Type "Bearer" followed by a space and the access token.
 func main() {
 
 	config, err := util.LoadConfig(".")
@@ -23,9 +37,41 @@ func main() {
 		log.Fatal("cannot load config:", err)
 	}
 
+	wf, err := workflow.Load(config.WorkflowFile)
+	if err != nil {
+		log.Fatal("cannot load workflow:", err)
+	}
+	// REST, GraphQL, gRPC 요청의 todo_status 태그는 모두 이 워크플로의 상태만 허용합니다.
+	err = response.RegisterValidation("todo_status", func(fl validator.FieldLevel) bool {
+		return wf.IsState(fl.Field().String())
+	})
+	if err != nil {
+		log.Fatal("cannot register status validation:", err)
+	}
+
+	client := database.InitDB()
+	todoService := service.NewTodoService(client, wf, config)
+	trash := map[string]worker.Purgeable{
+		"todos":    todoService,
+		"projects": service.NewProjectService(client),
+	}
+	go worker.NewTrashPurger(trash, config.TrashRetention, config.TrashPurgeInterval).Run(context.Background())
+	go worker.NewReminderScheduler(todoService, worker.LogNotifier{}, config.ReminderInterval).Run(context.Background())
+	go worker.NewWebhookDispatcher(service.NewWebhookService(client, config), config.WebhookTimeout, config.WebhookInterval).Run(context.Background())
+
+	if config.GRPCPort != "" {
+		go func() {
+			listener, err := net.Listen("tcp", config.GRPCPort)
+			if err != nil {
+				log.Fatal("cannot listen for grpc:", err)
+			}
+			log.Fatal(grpcserver.NewServer(todoService, token.InitMaker()).Serve(listener))
+		}()
+	}
+
 	server := &http.Server{
 		Addr:    config.PORT,
-		Handler: routes.Router(),
+		Handler: routes.Router(wf),
 	}
 
 	log.Fatal(server.ListenAndServe())
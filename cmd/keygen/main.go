@@ -0,0 +1,20 @@
+// Command keygen prints a new ed25519 key pair for signing PASETO tokens as the
+// SECRET_KEY_HEX and PUBLIC_KEY_HEX settings.
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+)
+
+func main() {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		log.Fatalf("failed generating key pair: %v", err)
+	}
+	fmt.Printf("SECRET_KEY_HEX=%s\n", hex.EncodeToString(privateKey))
+	fmt.Printf("PUBLIC_KEY_HEX=%s\n", hex.EncodeToString(publicKey))
+}
@@ -3,13 +3,30 @@ package database
 import (
 	"context"
 	"log"
+	"sync"
 	"todo-api-golang/ent"
+	"todo-api-golang/internal/events"
+	"todo-api-golang/internal/revision"
+	"todo-api-golang/internal/webhook"
 	"todo-api-golang/util"
 
 	_ "github.com/go-sql-driver/mysql"
 )
 
+var (
+	client     *ent.Client
+	clientOnce sync.Once
+)
+
+// InitDB는 ent 클라이언트를 한 번만 생성하고, 이후 호출에서는 같은 클라이언트를 반환합니다.
 func InitDB() *ent.Client {
+	clientOnce.Do(func() {
+		client = openDB()
+	})
+	return client
+}
+
+func openDB() *ent.Client {
 	config, err := util.LoadConfig(".")
 
 	if err != nil {
@@ -23,6 +40,10 @@ func InitDB() *ent.Client {
 		log.Fatalf("failed opening connection to mysql: %v", err)
 	}
 	//defer client.Close()
+	// 모든 할 일 변경이 이력, 이벤트, 웹훅 전송으로 남도록 훅은 클라이언트를 만들 때 등록합니다.
+	revision.Register(client)
+	events.Register(client, events.InitBroker())
+	webhook.Register(client)
 	// Run the auto migration tool.
 	if err := client.Schema.Create(context.Background()); err != nil {
 		log.Fatalf("failed creating schema resources: %v", err)
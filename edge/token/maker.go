@@ -0,0 +1,103 @@
+package token
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+	"todo-api-golang/util"
+
+	"github.com/o1egl/paseto"
+)
+
+// Maker는 토큰을 발급하고 검증하는 인터페이스입니다.
+type Maker interface {
+	CreateToken(userID int, tokenType Type, duration time.Duration) (string, *Payload, error)
+	VerifyToken(token string, tokenType Type) (*Payload, error)
+}
+
+// PasetoMaker signs PASETO v2.public tokens with an ed25519 key pair.
+type PasetoMaker struct {
+	paseto     *paseto.V2
+	privateKey ed25519.PrivateKey
+	publicKey  ed25519.PublicKey
+}
+
+// NewPasetoMaker creates a PasetoMaker from hex-encoded ed25519 keys.
+// It fails when a key is missing or malformed and when the keys do not form a pair.
+func NewPasetoMaker(secretKeyHex, publicKeyHex string) (Maker, error) {
+	if secretKeyHex == "" || publicKeyHex == "" {
+		return nil, fmt.Errorf("missing key: set SECRET_KEY_HEX and PUBLIC_KEY_HEX, e.g. with the output of make keys")
+	}
+	privateKey, err := hex.DecodeString(secretKeyHex)
+	if err != nil || len(privateKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid secret key: must be %d hex-encoded bytes", ed25519.PrivateKeySize)
+	}
+	publicKey, err := hex.DecodeString(publicKeyHex)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid public key: must be %d hex-encoded bytes", ed25519.PublicKeySize)
+	}
+	if !bytes.Equal(ed25519.PrivateKey(privateKey).Public().(ed25519.PublicKey), publicKey) {
+		return nil, fmt.Errorf("invalid key pair: the public key does not belong to the secret key")
+	}
+
+	return &PasetoMaker{
+		paseto:     paseto.NewV2(),
+		privateKey: ed25519.PrivateKey(privateKey),
+		publicKey:  ed25519.PublicKey(publicKey),
+	}, nil
+}
+
+// CreateToken creates a signed token for the given user and duration.
+func (m *PasetoMaker) CreateToken(userID int, tokenType Type, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(userID, tokenType, duration)
+	if err != nil {
+		return "", nil, err
+	}
+
+	token, err := m.paseto.Sign(m.privateKey, payload, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	return token, payload, nil
+}
+
+// VerifyToken checks the signature, expiry and type of the token.
+func (m *PasetoMaker) VerifyToken(token string, tokenType Type) (*Payload, error) {
+	payload := &Payload{}
+
+	if err := m.paseto.Verify(token, m.publicKey, payload, nil); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if payload.Type != tokenType {
+		return nil, ErrInvalidToken
+	}
+	if err := payload.Valid(); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+var (
+	maker     Maker
+	makerOnce sync.Once
+)
+
+// InitMaker는 설정 파일의 키로 Maker를 한 번만 생성하고, 이후 호출에서는 같은 Maker를 반환합니다.
+func InitMaker() Maker {
+	makerOnce.Do(func() {
+		config, err := util.LoadConfig(".")
+		if err != nil {
+			panic(err)
+		}
+
+		maker, err = NewPasetoMaker(config.SecretKeyHex, config.PublicKeyHex)
+		if err != nil {
+			log.Fatalf("failed creating token maker: %v", err)
+		}
+	})
+	return maker
+}
@@ -0,0 +1,55 @@
+package token
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidToken = errors.New("token is invalid")
+	ErrExpiredToken = errors.New("token has expired")
+)
+
+// Type는 토큰의 용도(access / refresh)를 구분합니다.
+type Type string
+
+const (
+	TypeAccess  Type = "access"
+	TypeRefresh Type = "refresh"
+)
+
+// Payload는 토큰에 서명되어 담기는 데이터입니다.
+type Payload struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    int       `json:"user_id"`
+	Type      Type      `json:"type"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+// NewPayload creates a new token payload for the given user.
+func NewPayload(userID int, tokenType Type, duration time.Duration) (*Payload, error) {
+	tokenID, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Payload{
+		ID:        tokenID,
+		UserID:    userID,
+		Type:      tokenType,
+		IssuedAt:  now,
+		ExpiredAt: now.Add(duration),
+	}, nil
+}
+
+// Valid checks whether the payload has expired.
+func (p *Payload) Valid() error {
+	if time.Now().After(p.ExpiredAt) {
+		return ErrExpiredToken
+	}
+	return nil
+}
@@ -8,7 +8,16 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"todo-api-golang/ent/idempotencykey"
+	"todo-api-golang/ent/project"
+	"todo-api-golang/ent/session"
+	"todo-api-golang/ent/tag"
 	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/todorevision"
+	"todo-api-golang/ent/todotransition"
+	"todo-api-golang/ent/user"
+	"todo-api-golang/ent/webhook"
+	"todo-api-golang/ent/webhookdelivery"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -73,7 +82,16 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			todo.Table: todo.ValidColumn,
+			idempotencykey.Table:  idempotencykey.ValidColumn,
+			project.Table:         project.ValidColumn,
+			session.Table:         session.ValidColumn,
+			tag.Table:             tag.ValidColumn,
+			todo.Table:            todo.ValidColumn,
+			todorevision.Table:    todorevision.ValidColumn,
+			todotransition.Table:  todotransition.ValidColumn,
+			user.Table:            user.ValidColumn,
+			webhook.Table:         webhook.ValidColumn,
+			webhookdelivery.Table: webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
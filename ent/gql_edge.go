@@ -0,0 +1,77 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func (t *Todo) Project(ctx context.Context) (*Project, error) {
+	result, err := t.Edges.ProjectOrErr()
+	if IsNotLoaded(err) {
+		result, err = t.QueryProject().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (t *Todo) Tags(ctx context.Context) (result []*Tag, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = t.NamedTags(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = t.Edges.TagsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = t.QueryTags().All(ctx)
+	}
+	return result, err
+}
+
+func (t *Todo) Parent(ctx context.Context) (*Todo, error) {
+	result, err := t.Edges.ParentOrErr()
+	if IsNotLoaded(err) {
+		result, err = t.QueryParent().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (t *Todo) Transitions(ctx context.Context) (result []*TodoTransition, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = t.NamedTransitions(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = t.Edges.TransitionsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = t.QueryTransitions().All(ctx)
+	}
+	return result, err
+}
+
+func (t *Todo) Revisions(ctx context.Context) (result []*TodoRevision, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = t.NamedRevisions(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = t.Edges.RevisionsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = t.QueryRevisions().All(ctx)
+	}
+	return result, err
+}
+
+func (tr *TodoRevision) Todo(ctx context.Context) (*Todo, error) {
+	result, err := tr.Edges.TodoOrErr()
+	if IsNotLoaded(err) {
+		result, err = tr.QueryTodo().Only(ctx)
+	}
+	return result, err
+}
+
+func (tt *TodoTransition) Todo(ctx context.Context) (*Todo, error) {
+	result, err := tt.Edges.TodoOrErr()
+	if IsNotLoaded(err) {
+		result, err = tt.QueryTodo().Only(ctx)
+	}
+	return result, err
+}
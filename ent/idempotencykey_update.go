@@ -0,0 +1,354 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-api-golang/ent/idempotencykey"
+	"todo-api-golang/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// IdempotencyKeyUpdate is the builder for updating IdempotencyKey entities.
+type IdempotencyKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IdempotencyKeyMutation
+}
+
+// Where appends a list predicates to the IdempotencyKeyUpdate builder.
+func (iku *IdempotencyKeyUpdate) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyUpdate {
+	iku.mutation.Where(ps...)
+	return iku
+}
+
+// SetStatusCode sets the "status_code" field.
+func (iku *IdempotencyKeyUpdate) SetStatusCode(i int) *IdempotencyKeyUpdate {
+	iku.mutation.ResetStatusCode()
+	iku.mutation.SetStatusCode(i)
+	return iku
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableStatusCode(i *int) *IdempotencyKeyUpdate {
+	if i != nil {
+		iku.SetStatusCode(*i)
+	}
+	return iku
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (iku *IdempotencyKeyUpdate) AddStatusCode(i int) *IdempotencyKeyUpdate {
+	iku.mutation.AddStatusCode(i)
+	return iku
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (iku *IdempotencyKeyUpdate) ClearStatusCode() *IdempotencyKeyUpdate {
+	iku.mutation.ClearStatusCode()
+	return iku
+}
+
+// SetHeader sets the "header" field.
+func (iku *IdempotencyKeyUpdate) SetHeader(m map[string]string) *IdempotencyKeyUpdate {
+	iku.mutation.SetHeader(m)
+	return iku
+}
+
+// ClearHeader clears the value of the "header" field.
+func (iku *IdempotencyKeyUpdate) ClearHeader() *IdempotencyKeyUpdate {
+	iku.mutation.ClearHeader()
+	return iku
+}
+
+// SetBody sets the "body" field.
+func (iku *IdempotencyKeyUpdate) SetBody(b []byte) *IdempotencyKeyUpdate {
+	iku.mutation.SetBody(b)
+	return iku
+}
+
+// ClearBody clears the value of the "body" field.
+func (iku *IdempotencyKeyUpdate) ClearBody() *IdempotencyKeyUpdate {
+	iku.mutation.ClearBody()
+	return iku
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iku *IdempotencyKeyUpdate) SetExpiresAt(t time.Time) *IdempotencyKeyUpdate {
+	iku.mutation.SetExpiresAt(t)
+	return iku
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableExpiresAt(t *time.Time) *IdempotencyKeyUpdate {
+	if t != nil {
+		iku.SetExpiresAt(*t)
+	}
+	return iku
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (iku *IdempotencyKeyUpdate) Mutation() *IdempotencyKeyMutation {
+	return iku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iku *IdempotencyKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iku.sqlSave, iku.mutation, iku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iku *IdempotencyKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := iku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iku *IdempotencyKeyUpdate) Exec(ctx context.Context) error {
+	_, err := iku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iku *IdempotencyKeyUpdate) ExecX(ctx context.Context) {
+	if err := iku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iku *IdempotencyKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(idempotencykey.Table, idempotencykey.Columns, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeInt))
+	if ps := iku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iku.mutation.StatusCode(); ok {
+		_spec.SetField(idempotencykey.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := iku.mutation.AddedStatusCode(); ok {
+		_spec.AddField(idempotencykey.FieldStatusCode, field.TypeInt, value)
+	}
+	if iku.mutation.StatusCodeCleared() {
+		_spec.ClearField(idempotencykey.FieldStatusCode, field.TypeInt)
+	}
+	if value, ok := iku.mutation.Header(); ok {
+		_spec.SetField(idempotencykey.FieldHeader, field.TypeJSON, value)
+	}
+	if iku.mutation.HeaderCleared() {
+		_spec.ClearField(idempotencykey.FieldHeader, field.TypeJSON)
+	}
+	if value, ok := iku.mutation.Body(); ok {
+		_spec.SetField(idempotencykey.FieldBody, field.TypeBytes, value)
+	}
+	if iku.mutation.BodyCleared() {
+		_spec.ClearField(idempotencykey.FieldBody, field.TypeBytes)
+	}
+	if value, ok := iku.mutation.ExpiresAt(); ok {
+		_spec.SetField(idempotencykey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iku.mutation.done = true
+	return n, nil
+}
+
+// IdempotencyKeyUpdateOne is the builder for updating a single IdempotencyKey entity.
+type IdempotencyKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IdempotencyKeyMutation
+}
+
+// SetStatusCode sets the "status_code" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetStatusCode(i int) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ResetStatusCode()
+	ikuo.mutation.SetStatusCode(i)
+	return ikuo
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableStatusCode(i *int) *IdempotencyKeyUpdateOne {
+	if i != nil {
+		ikuo.SetStatusCode(*i)
+	}
+	return ikuo
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (ikuo *IdempotencyKeyUpdateOne) AddStatusCode(i int) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.AddStatusCode(i)
+	return ikuo
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearStatusCode() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearStatusCode()
+	return ikuo
+}
+
+// SetHeader sets the "header" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetHeader(m map[string]string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetHeader(m)
+	return ikuo
+}
+
+// ClearHeader clears the value of the "header" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearHeader() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearHeader()
+	return ikuo
+}
+
+// SetBody sets the "body" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetBody(b []byte) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetBody(b)
+	return ikuo
+}
+
+// ClearBody clears the value of the "body" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearBody() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearBody()
+	return ikuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetExpiresAt(t time.Time) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetExpiresAt(t)
+	return ikuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableExpiresAt(t *time.Time) *IdempotencyKeyUpdateOne {
+	if t != nil {
+		ikuo.SetExpiresAt(*t)
+	}
+	return ikuo
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (ikuo *IdempotencyKeyUpdateOne) Mutation() *IdempotencyKeyMutation {
+	return ikuo.mutation
+}
+
+// Where appends a list predicates to the IdempotencyKeyUpdate builder.
+func (ikuo *IdempotencyKeyUpdateOne) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.Where(ps...)
+	return ikuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ikuo *IdempotencyKeyUpdateOne) Select(field string, fields ...string) *IdempotencyKeyUpdateOne {
+	ikuo.fields = append([]string{field}, fields...)
+	return ikuo
+}
+
+// Save executes the query and returns the updated IdempotencyKey entity.
+func (ikuo *IdempotencyKeyUpdateOne) Save(ctx context.Context) (*IdempotencyKey, error) {
+	return withHooks(ctx, ikuo.sqlSave, ikuo.mutation, ikuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikuo *IdempotencyKeyUpdateOne) SaveX(ctx context.Context) *IdempotencyKey {
+	node, err := ikuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ikuo *IdempotencyKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := ikuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikuo *IdempotencyKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := ikuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ikuo *IdempotencyKeyUpdateOne) sqlSave(ctx context.Context) (_node *IdempotencyKey, err error) {
+	_spec := sqlgraph.NewUpdateSpec(idempotencykey.Table, idempotencykey.Columns, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeInt))
+	id, ok := ikuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IdempotencyKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ikuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, idempotencykey.FieldID)
+		for _, f := range fields {
+			if !idempotencykey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != idempotencykey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ikuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ikuo.mutation.StatusCode(); ok {
+		_spec.SetField(idempotencykey.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := ikuo.mutation.AddedStatusCode(); ok {
+		_spec.AddField(idempotencykey.FieldStatusCode, field.TypeInt, value)
+	}
+	if ikuo.mutation.StatusCodeCleared() {
+		_spec.ClearField(idempotencykey.FieldStatusCode, field.TypeInt)
+	}
+	if value, ok := ikuo.mutation.Header(); ok {
+		_spec.SetField(idempotencykey.FieldHeader, field.TypeJSON, value)
+	}
+	if ikuo.mutation.HeaderCleared() {
+		_spec.ClearField(idempotencykey.FieldHeader, field.TypeJSON)
+	}
+	if value, ok := ikuo.mutation.Body(); ok {
+		_spec.SetField(idempotencykey.FieldBody, field.TypeBytes, value)
+	}
+	if ikuo.mutation.BodyCleared() {
+		_spec.ClearField(idempotencykey.FieldBody, field.TypeBytes)
+	}
+	if value, ok := ikuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(idempotencykey.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &IdempotencyKey{config: ikuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ikuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ikuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+// It stores the response of a request sent with an Idempotency-Key header, see middleware/idempotency.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Default(0).
+			Immutable().
+			Comment("The user who sent the request, or 0 for unauthenticated requests."),
+		field.String("key").
+			MaxLen(255).
+			NotEmpty().
+			Immutable(),
+		field.String("request_hash").
+			Immutable().
+			Comment("SHA-256 of the method, path and body of the request."),
+		field.Int("status_code").
+			Optional().
+			Nillable().
+			Comment("Empty while the request is still being handled."),
+		field.JSON("header", map[string]string{}).
+			Optional(),
+		field.Bytes("body").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at"),
+	}
+}
+
+// Annotations of the IdempotencyKey. It is not exposed over GraphQL.
+func (IdempotencyKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+// Indexes of the IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "key").Unique(),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Project holds the schema definition for the Project entity.
+// A project groups the todos of a single user.
+type Project struct {
+	ent.Schema
+}
+
+// Fields of the Project.
+func (Project) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("color").
+			Optional().
+			Comment("Hex colour code such as #4caf50."),
+		field.Bool("archived").Default(false),
+		field.Time("deleted_at").Optional().Nillable(),
+		field.Int("user_id").
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Annotations of the Project. It is only exposed over GraphQL through the todos.
+func (Project) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipOrderField),
+	}
+}
+
+// Edges of the Project.
+func (Project) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("projects").
+			Field("user_id").
+			Unique().
+			Required().
+			Annotations(entgql.Skip()),
+		edge.To("todos", Todo.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull), entgql.Skip()),
+	}
+}
+
+func (Project) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CustomTimeMixin{},
+	}
+}
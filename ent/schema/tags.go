@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Tag holds the schema definition for the Tag entity.
+// Tag names are stored in lower case and are unique per user.
+type Tag struct {
+	ent.Schema
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(50),
+		field.Int("user_id").
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Annotations of the Tag. It is only exposed over GraphQL through the todos.
+func (Tag) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipOrderField),
+	}
+}
+
+// Edges of the Tag.
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("tags").
+			Field("user_id").
+			Unique().
+			Required().
+			Annotations(entgql.Skip()),
+		edge.To("todos", Todo.Type).
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Indexes of the Tag.
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "name").Unique(),
+	}
+}
+
+func (Tag) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CustomTimeMixin{},
+	}
+}
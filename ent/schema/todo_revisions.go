@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// TodoRevision holds the schema definition for the TodoRevision entity.
+// Revisions are written by the hook in internal/revision and are never updated.
+type TodoRevision struct {
+	ent.Schema
+}
+
+// FieldChange is the value of a todo field before and after a change.
+type FieldChange struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+// TodoSnapshot is the state of a todo right after a change.
+type TodoSnapshot struct {
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	Status       string     `json:"status"`
+	Priority     int        `json:"priority"`
+	Position     string     `json:"position"`
+	ProjectID    *int       `json:"project_id"`
+	ParentID     *int       `json:"parent_id"`
+	AutoComplete bool       `json:"auto_complete"`
+	DueAt        *time.Time `json:"due_at"`
+	RemindAt     *time.Time `json:"remind_at"`
+	StartedAt    *time.Time `json:"started_at"`
+	CompletedAt  *time.Time `json:"completed_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
+	Tags         []string   `json:"tags"`
+}
+
+// Fields of the TodoRevision.
+func (TodoRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("todo_id").Immutable(),
+		field.Int("user_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The user who made the change. Empty for changes made by background workers."),
+		field.Enum("action").
+			Values("create", "update", "status", "delete", "restore").
+			Immutable(),
+		field.JSON("changes", map[string]FieldChange{}).
+			Immutable().
+			Comment("The changed fields, keyed by their JSON name.").
+			Annotations(entgql.Skip()),
+		field.JSON("snapshot", TodoSnapshot{}).
+			Immutable().
+			Annotations(entgql.Skip()),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the TodoRevision.
+func (TodoRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("todo", Todo.Type).
+			Ref("revisions").
+			Field("todo_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the TodoRevision.
+func (TodoRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("todo_id", "created_at"),
+	}
+}
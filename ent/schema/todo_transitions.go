@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// TodoTransition holds the schema definition for the TodoTransition entity.
+// A transition is recorded every time the status of a todo changes.
+type TodoTransition struct {
+	ent.Schema
+}
+
+// Fields of the TodoTransition.
+func (TodoTransition) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("todo_id"),
+		field.String("from_status").
+			Optional().
+			Nillable().
+			Comment("Empty for the initial status of a new todo."),
+		field.String("to_status").NotEmpty(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the TodoTransition.
+func (TodoTransition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("todo", Todo.Type).
+			Ref("transitions").
+			Field("todo_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the TodoTransition.
+func (TodoTransition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("todo_id", "created_at"),
+	}
+}
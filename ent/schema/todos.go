@@ -1,9 +1,15 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
 	"time"
 )
 
@@ -15,12 +21,107 @@ type Todo struct {
 // Fields of the Todo.
 func (Todo) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("title").NotEmpty(),
+		field.String("title").
+			NotEmpty().
+			Annotations(entgql.OrderField("TITLE")),
 		field.Text("description").Optional(),
-		field.Enum("status").
-			Values("PENDING", "COMPLETED", "PROGRESS").
-			Default("PENDING"),
+		field.String("status").
+			NotEmpty().
+			Default("PENDING").
+			Comment("A state of the configured workflow, see internal/workflow."),
+		field.Time("started_at").
+			Optional().
+			Nillable().
+			Comment("The time when the todo first entered a started state."),
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("The time when the todo entered a done state. Cleared when it is reopened."),
 		field.Time("deleted_at").Optional().Nillable(),
+		field.Int("user_id").
+			Annotations(entgql.Skip()),
+		field.Int("project_id").Optional().Nillable(),
+		field.Int("parent_id").Optional().Nillable(),
+		field.Bool("auto_complete").
+			Default(false).
+			Comment("Complete the todo automatically when all of its subtasks are completed."),
+		field.Time("due_at").
+			Optional().
+			Nillable().
+			Annotations(entgql.OrderField("DUE_AT")),
+		field.Time("remind_at").Optional().Nillable(),
+		field.Time("reminded_at").
+			Optional().
+			Nillable().
+			Comment("The time when the reminder for remind_at was sent.").
+			Annotations(entgql.Skip()),
+		field.Int("priority").
+			Range(0, 3).
+			Default(1).
+			Comment("0: LOW, 1: MEDIUM, 2: HIGH, 3: URGENT. Stored as a number so that it sorts by importance.").
+			Annotations(entgql.Type("Priority"), entgql.OrderField("PRIORITY")),
+		field.String("position").
+			NotEmpty().
+			Default("a0").
+			Comment("Lexicographic key for the manual ordering, see internal/rank.").
+			Annotations(entgql.OrderField("POSITION")),
+		field.Int("version").
+			Default(1).
+			Comment("Incremented on every change, see internal/revision. Exposed as the ETag of the todo."),
+		field.String("uid").
+			Optional().
+			Nillable().
+			Immutable().
+			DefaultFunc(func() string { return uuid.NewString() }).
+			Comment("The iCalendar UID of the todo. Imports update the todo with the UID of a VTODO instead of creating another one.").
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Edges of the Todo.
+func (Todo) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("todos").
+			Field("user_id").
+			Unique().
+			Required().
+			Annotations(entgql.Skip()),
+		edge.From("project", Project.Type).
+			Ref("todos").
+			Field("project_id").
+			Unique(),
+		edge.From("tags", Tag.Type).
+			Ref("todos"),
+		// 하위 할 일은 휴지통을 제외하는 subtasks 필드(internal/graph/todo.graphql)로 제공합니다.
+		edge.To("children", Todo.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade), entgql.Skip()).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		edge.To("transitions", TodoTransition.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("revisions", TodoRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Annotations of the Todo.
+func (Todo) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField().Description("The todos outside the trash."),
+		entgql.RelayConnection(),
+		entgql.MultiOrder(),
+	}
+}
+
+// Indexes of the Todo.
+func (Todo) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "due_at"),
+		index.Fields("remind_at", "reminded_at"),
+		index.Fields("user_id", "position"),
+		index.Fields("user_id", "uid").Unique(),
 	}
 }
 
@@ -40,10 +141,12 @@ func (CustomTimeMixin) Fields() []ent.Field {
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
-			Comment("The time when the entity was created."),
+			Comment("The time when the entity was created.").
+			Annotations(entgql.OrderField("CREATED_AT")),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now).
-			Comment("The time when the entity was last updated."),
+			Comment("The time when the entity was last updated.").
+			Annotations(entgql.OrderField("UPDATED_AT")),
 	}
 }
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").
+			NotEmpty().
+			Unique(),
+		field.String("display_name").NotEmpty(),
+		field.String("password_hash").
+			NotEmpty().
+			Sensitive(),
+		field.String("calendar_token_hash").
+			Optional().
+			Nillable().
+			Unique().
+			Sensitive().
+			Comment("SHA-256 of the token of the user's calendar feed; nil when no feed token was issued."),
+	}
+}
+
+// Annotations of the User. It is not exposed over GraphQL.
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("sessions", Session.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("todos", Todo.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("projects", Project.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tags", Tag.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("webhooks", Webhook.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CustomTimeMixin{},
+	}
+}
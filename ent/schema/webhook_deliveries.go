@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// Deliveries are written in the transaction of the change they report and sent by the webhook worker.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("webhook_id").
+			Immutable(),
+		field.Int("event_id").
+			Immutable().
+			Comment("The ID of the event, which is the ID of the todo revision."),
+		field.String("event_type").
+			Immutable(),
+		field.Bytes("payload").
+			Immutable().
+			Comment("The JSON body sent to the webhook."),
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending"),
+		field.Int("attempts").
+			Default(0),
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable().
+			Comment("When the next attempt is due; empty once the delivery succeeded or failed."),
+		field.Int("response_status").
+			Optional().
+			Nillable(),
+		field.Text("last_error").
+			Optional(),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Annotations of the WebhookDelivery. It is not exposed over GraphQL.
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Field("webhook_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("webhook_id", "created_at"),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+// A webhook receives a signed delivery for every change of the owner's todos, see internal/webhook.
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048),
+		field.String("secret").
+			NotEmpty().
+			Sensitive().
+			Comment("The key the deliveries are signed with."),
+		field.JSON("events", []string{}).
+			Optional().
+			Comment("The event types delivered to the webhook; empty for every type."),
+		field.Bool("active").
+			Default(true),
+		field.Int("user_id"),
+	}
+}
+
+// Annotations of the Webhook. It is not exposed over GraphQL.
+func (Webhook) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("webhooks").
+			Field("user_id").
+			Unique().
+			Required(),
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CustomTimeMixin{},
+	}
+}
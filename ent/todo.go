@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"todo-api-golang/ent/project"
 	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/user"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -25,23 +27,151 @@ type Todo struct {
 	Title string `json:"title,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
-	// Status holds the value of the "status" field.
-	Status todo.Status `json:"status,omitempty"`
+	// A state of the configured workflow, see internal/workflow.
+	Status string `json:"status,omitempty"`
+	// The time when the todo first entered a started state.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// The time when the todo entered a done state. Cleared when it is reopened.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
-	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID *int `json:"project_id,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *int `json:"parent_id,omitempty"`
+	// Complete the todo automatically when all of its subtasks are completed.
+	AutoComplete bool `json:"auto_complete,omitempty"`
+	// DueAt holds the value of the "due_at" field.
+	DueAt *time.Time `json:"due_at,omitempty"`
+	// RemindAt holds the value of the "remind_at" field.
+	RemindAt *time.Time `json:"remind_at,omitempty"`
+	// The time when the reminder for remind_at was sent.
+	RemindedAt *time.Time `json:"reminded_at,omitempty"`
+	// 0: LOW, 1: MEDIUM, 2: HIGH, 3: URGENT. Stored as a number so that it sorts by importance.
+	Priority int `json:"priority,omitempty"`
+	// Lexicographic key for the manual ordering, see internal/rank.
+	Position string `json:"position,omitempty"`
+	// Incremented on every change, see internal/revision. Exposed as the ETag of the todo.
+	Version int `json:"version,omitempty"`
+	// The iCalendar UID of the todo. Imports update the todo with the UID of a VTODO instead of creating another one.
+	UID *string `json:"uid,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoQuery when eager-loading is set.
+	Edges        TodoEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// TodoEdges holds the relations/edges for other nodes in the graph.
+type TodoEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Todo `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Todo `json:"children,omitempty"`
+	// Transitions holds the value of the transitions edge.
+	Transitions []*TodoTransition `json:"transitions,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*TodoRevision `json:"revisions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [7]bool
+	// totalCount holds the count of the edges above.
+	totalCount [5]map[string]int
+
+	namedTags        map[string][]*Tag
+	namedChildren    map[string][]*Todo
+	namedTransitions map[string][]*TodoTransition
+	namedRevisions   map[string][]*TodoRevision
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[2] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) ParentOrErr() (*Todo, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: todo.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) ChildrenOrErr() ([]*Todo, error) {
+	if e.loadedTypes[4] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// TransitionsOrErr returns the Transitions value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) TransitionsOrErr() ([]*TodoTransition, error) {
+	if e.loadedTypes[5] {
+		return e.Transitions, nil
+	}
+	return nil, &NotLoadedError{edge: "transitions"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) RevisionsOrErr() ([]*TodoRevision, error) {
+	if e.loadedTypes[6] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Todo) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case todo.FieldID:
+		case todo.FieldAutoComplete:
+			values[i] = new(sql.NullBool)
+		case todo.FieldID, todo.FieldUserID, todo.FieldProjectID, todo.FieldParentID, todo.FieldPriority, todo.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case todo.FieldTitle, todo.FieldDescription, todo.FieldStatus:
+		case todo.FieldTitle, todo.FieldDescription, todo.FieldStatus, todo.FieldPosition, todo.FieldUID:
 			values[i] = new(sql.NullString)
-		case todo.FieldCreatedAt, todo.FieldUpdatedAt, todo.FieldDeletedAt:
+		case todo.FieldCreatedAt, todo.FieldUpdatedAt, todo.FieldStartedAt, todo.FieldCompletedAt, todo.FieldDeletedAt, todo.FieldDueAt, todo.FieldRemindAt, todo.FieldRemindedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -92,7 +222,21 @@ func (t *Todo) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
-				t.Status = todo.Status(value.String)
+				t.Status = value.String
+			}
+		case todo.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				t.StartedAt = new(time.Time)
+				*t.StartedAt = value.Time
+			}
+		case todo.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				t.CompletedAt = new(time.Time)
+				*t.CompletedAt = value.Time
 			}
 		case todo.FieldDeletedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -101,6 +245,78 @@ func (t *Todo) assignValues(columns []string, values []any) error {
 				t.DeletedAt = new(time.Time)
 				*t.DeletedAt = value.Time
 			}
+		case todo.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				t.UserID = int(value.Int64)
+			}
+		case todo.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				t.ProjectID = new(int)
+				*t.ProjectID = int(value.Int64)
+			}
+		case todo.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				t.ParentID = new(int)
+				*t.ParentID = int(value.Int64)
+			}
+		case todo.FieldAutoComplete:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_complete", values[i])
+			} else if value.Valid {
+				t.AutoComplete = value.Bool
+			}
+		case todo.FieldDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				t.DueAt = new(time.Time)
+				*t.DueAt = value.Time
+			}
+		case todo.FieldRemindAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field remind_at", values[i])
+			} else if value.Valid {
+				t.RemindAt = new(time.Time)
+				*t.RemindAt = value.Time
+			}
+		case todo.FieldRemindedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reminded_at", values[i])
+			} else if value.Valid {
+				t.RemindedAt = new(time.Time)
+				*t.RemindedAt = value.Time
+			}
+		case todo.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				t.Priority = int(value.Int64)
+			}
+		case todo.FieldPosition:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				t.Position = value.String
+			}
+		case todo.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				t.Version = int(value.Int64)
+			}
+		case todo.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				t.UID = new(string)
+				*t.UID = value.String
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -114,6 +330,41 @@ func (t *Todo) Value(name string) (ent.Value, error) {
 	return t.selectValues.Get(name)
 }
 
+// QueryOwner queries the "owner" edge of the Todo entity.
+func (t *Todo) QueryOwner() *UserQuery {
+	return NewTodoClient(t.config).QueryOwner(t)
+}
+
+// QueryProject queries the "project" edge of the Todo entity.
+func (t *Todo) QueryProject() *ProjectQuery {
+	return NewTodoClient(t.config).QueryProject(t)
+}
+
+// QueryTags queries the "tags" edge of the Todo entity.
+func (t *Todo) QueryTags() *TagQuery {
+	return NewTodoClient(t.config).QueryTags(t)
+}
+
+// QueryParent queries the "parent" edge of the Todo entity.
+func (t *Todo) QueryParent() *TodoQuery {
+	return NewTodoClient(t.config).QueryParent(t)
+}
+
+// QueryChildren queries the "children" edge of the Todo entity.
+func (t *Todo) QueryChildren() *TodoQuery {
+	return NewTodoClient(t.config).QueryChildren(t)
+}
+
+// QueryTransitions queries the "transitions" edge of the Todo entity.
+func (t *Todo) QueryTransitions() *TodoTransitionQuery {
+	return NewTodoClient(t.config).QueryTransitions(t)
+}
+
+// QueryRevisions queries the "revisions" edge of the Todo entity.
+func (t *Todo) QueryRevisions() *TodoRevisionQuery {
+	return NewTodoClient(t.config).QueryRevisions(t)
+}
+
 // Update returns a builder for updating this Todo.
 // Note that you need to call Todo.Unwrap() before calling this method if this Todo
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -150,15 +401,166 @@ func (t *Todo) String() string {
 	builder.WriteString(t.Description)
 	builder.WriteString(", ")
 	builder.WriteString("status=")
-	builder.WriteString(fmt.Sprintf("%v", t.Status))
+	builder.WriteString(t.Status)
+	builder.WriteString(", ")
+	if v := t.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := t.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteString(", ")
 	if v := t.DeletedAt; v != nil {
 		builder.WriteString("deleted_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.UserID))
+	builder.WriteString(", ")
+	if v := t.ProjectID; v != nil {
+		builder.WriteString("project_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("auto_complete=")
+	builder.WriteString(fmt.Sprintf("%v", t.AutoComplete))
+	builder.WriteString(", ")
+	if v := t.DueAt; v != nil {
+		builder.WriteString("due_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := t.RemindAt; v != nil {
+		builder.WriteString("remind_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := t.RemindedAt; v != nil {
+		builder.WriteString("reminded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", t.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(t.Position)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", t.Version))
+	builder.WriteString(", ")
+	if v := t.UID; v != nil {
+		builder.WriteString("uid=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedTags returns the Tags named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (t *Todo) NamedTags(name string) ([]*Tag, error) {
+	if t.Edges.namedTags == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := t.Edges.namedTags[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (t *Todo) appendNamedTags(name string, edges ...*Tag) {
+	if t.Edges.namedTags == nil {
+		t.Edges.namedTags = make(map[string][]*Tag)
+	}
+	if len(edges) == 0 {
+		t.Edges.namedTags[name] = []*Tag{}
+	} else {
+		t.Edges.namedTags[name] = append(t.Edges.namedTags[name], edges...)
+	}
+}
+
+// NamedChildren returns the Children named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (t *Todo) NamedChildren(name string) ([]*Todo, error) {
+	if t.Edges.namedChildren == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := t.Edges.namedChildren[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (t *Todo) appendNamedChildren(name string, edges ...*Todo) {
+	if t.Edges.namedChildren == nil {
+		t.Edges.namedChildren = make(map[string][]*Todo)
+	}
+	if len(edges) == 0 {
+		t.Edges.namedChildren[name] = []*Todo{}
+	} else {
+		t.Edges.namedChildren[name] = append(t.Edges.namedChildren[name], edges...)
+	}
+}
+
+// NamedTransitions returns the Transitions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (t *Todo) NamedTransitions(name string) ([]*TodoTransition, error) {
+	if t.Edges.namedTransitions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := t.Edges.namedTransitions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (t *Todo) appendNamedTransitions(name string, edges ...*TodoTransition) {
+	if t.Edges.namedTransitions == nil {
+		t.Edges.namedTransitions = make(map[string][]*TodoTransition)
+	}
+	if len(edges) == 0 {
+		t.Edges.namedTransitions[name] = []*TodoTransition{}
+	} else {
+		t.Edges.namedTransitions[name] = append(t.Edges.namedTransitions[name], edges...)
+	}
+}
+
+// NamedRevisions returns the Revisions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (t *Todo) NamedRevisions(name string) ([]*TodoRevision, error) {
+	if t.Edges.namedRevisions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := t.Edges.namedRevisions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (t *Todo) appendNamedRevisions(name string, edges ...*TodoRevision) {
+	if t.Edges.namedRevisions == nil {
+		t.Edges.namedRevisions = make(map[string][]*TodoRevision)
+	}
+	if len(edges) == 0 {
+		t.Edges.namedRevisions[name] = []*TodoRevision{}
+	} else {
+		t.Edges.namedRevisions[name] = append(t.Edges.namedRevisions[name], edges...)
+	}
+}
+
 // Todos is a parsable slice of Todo.
 type Todos []*Todo
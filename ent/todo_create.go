@@ -7,7 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"todo-api-golang/ent/project"
+	"todo-api-golang/ent/tag"
 	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/todorevision"
+	"todo-api-golang/ent/todotransition"
+	"todo-api-golang/ent/user"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -69,15 +74,43 @@ func (tc *TodoCreate) SetNillableDescription(s *string) *TodoCreate {
 }
 
 // SetStatus sets the "status" field.
-func (tc *TodoCreate) SetStatus(t todo.Status) *TodoCreate {
-	tc.mutation.SetStatus(t)
+func (tc *TodoCreate) SetStatus(s string) *TodoCreate {
+	tc.mutation.SetStatus(s)
 	return tc
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (tc *TodoCreate) SetNillableStatus(t *todo.Status) *TodoCreate {
+func (tc *TodoCreate) SetNillableStatus(s *string) *TodoCreate {
+	if s != nil {
+		tc.SetStatus(*s)
+	}
+	return tc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (tc *TodoCreate) SetStartedAt(t time.Time) *TodoCreate {
+	tc.mutation.SetStartedAt(t)
+	return tc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableStartedAt(t *time.Time) *TodoCreate {
+	if t != nil {
+		tc.SetStartedAt(*t)
+	}
+	return tc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tc *TodoCreate) SetCompletedAt(t time.Time) *TodoCreate {
+	tc.mutation.SetCompletedAt(t)
+	return tc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableCompletedAt(t *time.Time) *TodoCreate {
 	if t != nil {
-		tc.SetStatus(*t)
+		tc.SetCompletedAt(*t)
 	}
 	return tc
 }
@@ -96,6 +129,233 @@ func (tc *TodoCreate) SetNillableDeletedAt(t *time.Time) *TodoCreate {
 	return tc
 }
 
+// SetUserID sets the "user_id" field.
+func (tc *TodoCreate) SetUserID(i int) *TodoCreate {
+	tc.mutation.SetUserID(i)
+	return tc
+}
+
+// SetProjectID sets the "project_id" field.
+func (tc *TodoCreate) SetProjectID(i int) *TodoCreate {
+	tc.mutation.SetProjectID(i)
+	return tc
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableProjectID(i *int) *TodoCreate {
+	if i != nil {
+		tc.SetProjectID(*i)
+	}
+	return tc
+}
+
+// SetParentID sets the "parent_id" field.
+func (tc *TodoCreate) SetParentID(i int) *TodoCreate {
+	tc.mutation.SetParentID(i)
+	return tc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableParentID(i *int) *TodoCreate {
+	if i != nil {
+		tc.SetParentID(*i)
+	}
+	return tc
+}
+
+// SetAutoComplete sets the "auto_complete" field.
+func (tc *TodoCreate) SetAutoComplete(b bool) *TodoCreate {
+	tc.mutation.SetAutoComplete(b)
+	return tc
+}
+
+// SetNillableAutoComplete sets the "auto_complete" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableAutoComplete(b *bool) *TodoCreate {
+	if b != nil {
+		tc.SetAutoComplete(*b)
+	}
+	return tc
+}
+
+// SetDueAt sets the "due_at" field.
+func (tc *TodoCreate) SetDueAt(t time.Time) *TodoCreate {
+	tc.mutation.SetDueAt(t)
+	return tc
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableDueAt(t *time.Time) *TodoCreate {
+	if t != nil {
+		tc.SetDueAt(*t)
+	}
+	return tc
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (tc *TodoCreate) SetRemindAt(t time.Time) *TodoCreate {
+	tc.mutation.SetRemindAt(t)
+	return tc
+}
+
+// SetNillableRemindAt sets the "remind_at" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableRemindAt(t *time.Time) *TodoCreate {
+	if t != nil {
+		tc.SetRemindAt(*t)
+	}
+	return tc
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (tc *TodoCreate) SetRemindedAt(t time.Time) *TodoCreate {
+	tc.mutation.SetRemindedAt(t)
+	return tc
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableRemindedAt(t *time.Time) *TodoCreate {
+	if t != nil {
+		tc.SetRemindedAt(*t)
+	}
+	return tc
+}
+
+// SetPriority sets the "priority" field.
+func (tc *TodoCreate) SetPriority(i int) *TodoCreate {
+	tc.mutation.SetPriority(i)
+	return tc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tc *TodoCreate) SetNillablePriority(i *int) *TodoCreate {
+	if i != nil {
+		tc.SetPriority(*i)
+	}
+	return tc
+}
+
+// SetPosition sets the "position" field.
+func (tc *TodoCreate) SetPosition(s string) *TodoCreate {
+	tc.mutation.SetPosition(s)
+	return tc
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (tc *TodoCreate) SetNillablePosition(s *string) *TodoCreate {
+	if s != nil {
+		tc.SetPosition(*s)
+	}
+	return tc
+}
+
+// SetVersion sets the "version" field.
+func (tc *TodoCreate) SetVersion(i int) *TodoCreate {
+	tc.mutation.SetVersion(i)
+	return tc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableVersion(i *int) *TodoCreate {
+	if i != nil {
+		tc.SetVersion(*i)
+	}
+	return tc
+}
+
+// SetUID sets the "uid" field.
+func (tc *TodoCreate) SetUID(s string) *TodoCreate {
+	tc.mutation.SetUID(s)
+	return tc
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableUID(s *string) *TodoCreate {
+	if s != nil {
+		tc.SetUID(*s)
+	}
+	return tc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (tc *TodoCreate) SetOwnerID(id int) *TodoCreate {
+	tc.mutation.SetOwnerID(id)
+	return tc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (tc *TodoCreate) SetOwner(u *User) *TodoCreate {
+	return tc.SetOwnerID(u.ID)
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tc *TodoCreate) SetProject(p *Project) *TodoCreate {
+	return tc.SetProjectID(p.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tc *TodoCreate) AddTagIDs(ids ...int) *TodoCreate {
+	tc.mutation.AddTagIDs(ids...)
+	return tc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tc *TodoCreate) AddTags(t ...*Tag) *TodoCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddTagIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Todo entity.
+func (tc *TodoCreate) SetParent(t *Todo) *TodoCreate {
+	return tc.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Todo entity by IDs.
+func (tc *TodoCreate) AddChildIDs(ids ...int) *TodoCreate {
+	tc.mutation.AddChildIDs(ids...)
+	return tc
+}
+
+// AddChildren adds the "children" edges to the Todo entity.
+func (tc *TodoCreate) AddChildren(t ...*Todo) *TodoCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddChildIDs(ids...)
+}
+
+// AddTransitionIDs adds the "transitions" edge to the TodoTransition entity by IDs.
+func (tc *TodoCreate) AddTransitionIDs(ids ...int) *TodoCreate {
+	tc.mutation.AddTransitionIDs(ids...)
+	return tc
+}
+
+// AddTransitions adds the "transitions" edges to the TodoTransition entity.
+func (tc *TodoCreate) AddTransitions(t ...*TodoTransition) *TodoCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddTransitionIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the TodoRevision entity by IDs.
+func (tc *TodoCreate) AddRevisionIDs(ids ...int) *TodoCreate {
+	tc.mutation.AddRevisionIDs(ids...)
+	return tc
+}
+
+// AddRevisions adds the "revisions" edges to the TodoRevision entity.
+func (tc *TodoCreate) AddRevisions(t ...*TodoRevision) *TodoCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the TodoMutation object of the builder.
 func (tc *TodoCreate) Mutation() *TodoMutation {
 	return tc.mutation
@@ -143,6 +403,26 @@ func (tc *TodoCreate) defaults() {
 		v := todo.DefaultStatus
 		tc.mutation.SetStatus(v)
 	}
+	if _, ok := tc.mutation.AutoComplete(); !ok {
+		v := todo.DefaultAutoComplete
+		tc.mutation.SetAutoComplete(v)
+	}
+	if _, ok := tc.mutation.Priority(); !ok {
+		v := todo.DefaultPriority
+		tc.mutation.SetPriority(v)
+	}
+	if _, ok := tc.mutation.Position(); !ok {
+		v := todo.DefaultPosition
+		tc.mutation.SetPosition(v)
+	}
+	if _, ok := tc.mutation.Version(); !ok {
+		v := todo.DefaultVersion
+		tc.mutation.SetVersion(v)
+	}
+	if _, ok := tc.mutation.UID(); !ok {
+		v := todo.DefaultUID()
+		tc.mutation.SetUID(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -169,6 +449,34 @@ func (tc *TodoCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Todo.status": %w`, err)}
 		}
 	}
+	if _, ok := tc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Todo.user_id"`)}
+	}
+	if _, ok := tc.mutation.AutoComplete(); !ok {
+		return &ValidationError{Name: "auto_complete", err: errors.New(`ent: missing required field "Todo.auto_complete"`)}
+	}
+	if _, ok := tc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Todo.priority"`)}
+	}
+	if v, ok := tc.mutation.Priority(); ok {
+		if err := todo.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Todo.priority": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "Todo.position"`)}
+	}
+	if v, ok := tc.mutation.Position(); ok {
+		if err := todo.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Todo.position": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Todo.version"`)}
+	}
+	if len(tc.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Todo.owner"`)}
+	}
 	return nil
 }
 
@@ -212,13 +520,168 @@ func (tc *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 		_node.Description = value
 	}
 	if value, ok := tc.mutation.Status(); ok {
-		_spec.SetField(todo.FieldStatus, field.TypeEnum, value)
+		_spec.SetField(todo.FieldStatus, field.TypeString, value)
 		_node.Status = value
 	}
+	if value, ok := tc.mutation.StartedAt(); ok {
+		_spec.SetField(todo.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := tc.mutation.CompletedAt(); ok {
+		_spec.SetField(todo.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
 	if value, ok := tc.mutation.DeletedAt(); ok {
 		_spec.SetField(todo.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
 	}
+	if value, ok := tc.mutation.AutoComplete(); ok {
+		_spec.SetField(todo.FieldAutoComplete, field.TypeBool, value)
+		_node.AutoComplete = value
+	}
+	if value, ok := tc.mutation.DueAt(); ok {
+		_spec.SetField(todo.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = &value
+	}
+	if value, ok := tc.mutation.RemindAt(); ok {
+		_spec.SetField(todo.FieldRemindAt, field.TypeTime, value)
+		_node.RemindAt = &value
+	}
+	if value, ok := tc.mutation.RemindedAt(); ok {
+		_spec.SetField(todo.FieldRemindedAt, field.TypeTime, value)
+		_node.RemindedAt = &value
+	}
+	if value, ok := tc.mutation.Priority(); ok {
+		_spec.SetField(todo.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := tc.mutation.Position(); ok {
+		_spec.SetField(todo.FieldPosition, field.TypeString, value)
+		_node.Position = value
+	}
+	if value, ok := tc.mutation.Version(); ok {
+		_spec.SetField(todo.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := tc.mutation.UID(); ok {
+		_spec.SetField(todo.FieldUID, field.TypeString, value)
+		_node.UID = &value
+	}
+	if nodes := tc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.OwnerTable,
+			Columns: []string{todo.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.TransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.TransitionsTable,
+			Columns: []string{todo.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todotransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RevisionsTable,
+			Columns: []string{todo.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todorevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
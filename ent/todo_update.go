@@ -8,7 +8,12 @@ import (
 	"fmt"
 	"time"
 	"todo-api-golang/ent/predicate"
+	"todo-api-golang/ent/project"
+	"todo-api-golang/ent/tag"
 	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/todorevision"
+	"todo-api-golang/ent/todotransition"
+	"todo-api-golang/ent/user"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -69,225 +74,1370 @@ func (tu *TodoUpdate) ClearDescription() *TodoUpdate {
 }
 
 // SetStatus sets the "status" field.
-func (tu *TodoUpdate) SetStatus(t todo.Status) *TodoUpdate {
-	tu.mutation.SetStatus(t)
+func (tu *TodoUpdate) SetStatus(s string) *TodoUpdate {
+	tu.mutation.SetStatus(s)
 	return tu
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (tu *TodoUpdate) SetNillableStatus(t *todo.Status) *TodoUpdate {
+func (tu *TodoUpdate) SetNillableStatus(s *string) *TodoUpdate {
+	if s != nil {
+		tu.SetStatus(*s)
+	}
+	return tu
+}
+
+// SetStartedAt sets the "started_at" field.
+func (tu *TodoUpdate) SetStartedAt(t time.Time) *TodoUpdate {
+	tu.mutation.SetStartedAt(t)
+	return tu
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableStartedAt(t *time.Time) *TodoUpdate {
+	if t != nil {
+		tu.SetStartedAt(*t)
+	}
+	return tu
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (tu *TodoUpdate) ClearStartedAt() *TodoUpdate {
+	tu.mutation.ClearStartedAt()
+	return tu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tu *TodoUpdate) SetCompletedAt(t time.Time) *TodoUpdate {
+	tu.mutation.SetCompletedAt(t)
+	return tu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableCompletedAt(t *time.Time) *TodoUpdate {
+	if t != nil {
+		tu.SetCompletedAt(*t)
+	}
+	return tu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (tu *TodoUpdate) ClearCompletedAt() *TodoUpdate {
+	tu.mutation.ClearCompletedAt()
+	return tu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tu *TodoUpdate) SetDeletedAt(t time.Time) *TodoUpdate {
+	tu.mutation.SetDeletedAt(t)
+	return tu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableDeletedAt(t *time.Time) *TodoUpdate {
+	if t != nil {
+		tu.SetDeletedAt(*t)
+	}
+	return tu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tu *TodoUpdate) ClearDeletedAt() *TodoUpdate {
+	tu.mutation.ClearDeletedAt()
+	return tu
+}
+
+// SetUserID sets the "user_id" field.
+func (tu *TodoUpdate) SetUserID(i int) *TodoUpdate {
+	tu.mutation.SetUserID(i)
+	return tu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableUserID(i *int) *TodoUpdate {
+	if i != nil {
+		tu.SetUserID(*i)
+	}
+	return tu
+}
+
+// SetProjectID sets the "project_id" field.
+func (tu *TodoUpdate) SetProjectID(i int) *TodoUpdate {
+	tu.mutation.SetProjectID(i)
+	return tu
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableProjectID(i *int) *TodoUpdate {
+	if i != nil {
+		tu.SetProjectID(*i)
+	}
+	return tu
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (tu *TodoUpdate) ClearProjectID() *TodoUpdate {
+	tu.mutation.ClearProjectID()
+	return tu
+}
+
+// SetParentID sets the "parent_id" field.
+func (tu *TodoUpdate) SetParentID(i int) *TodoUpdate {
+	tu.mutation.SetParentID(i)
+	return tu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableParentID(i *int) *TodoUpdate {
+	if i != nil {
+		tu.SetParentID(*i)
+	}
+	return tu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tu *TodoUpdate) ClearParentID() *TodoUpdate {
+	tu.mutation.ClearParentID()
+	return tu
+}
+
+// SetAutoComplete sets the "auto_complete" field.
+func (tu *TodoUpdate) SetAutoComplete(b bool) *TodoUpdate {
+	tu.mutation.SetAutoComplete(b)
+	return tu
+}
+
+// SetNillableAutoComplete sets the "auto_complete" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableAutoComplete(b *bool) *TodoUpdate {
+	if b != nil {
+		tu.SetAutoComplete(*b)
+	}
+	return tu
+}
+
+// SetDueAt sets the "due_at" field.
+func (tu *TodoUpdate) SetDueAt(t time.Time) *TodoUpdate {
+	tu.mutation.SetDueAt(t)
+	return tu
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableDueAt(t *time.Time) *TodoUpdate {
+	if t != nil {
+		tu.SetDueAt(*t)
+	}
+	return tu
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (tu *TodoUpdate) ClearDueAt() *TodoUpdate {
+	tu.mutation.ClearDueAt()
+	return tu
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (tu *TodoUpdate) SetRemindAt(t time.Time) *TodoUpdate {
+	tu.mutation.SetRemindAt(t)
+	return tu
+}
+
+// SetNillableRemindAt sets the "remind_at" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableRemindAt(t *time.Time) *TodoUpdate {
+	if t != nil {
+		tu.SetRemindAt(*t)
+	}
+	return tu
+}
+
+// ClearRemindAt clears the value of the "remind_at" field.
+func (tu *TodoUpdate) ClearRemindAt() *TodoUpdate {
+	tu.mutation.ClearRemindAt()
+	return tu
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (tu *TodoUpdate) SetRemindedAt(t time.Time) *TodoUpdate {
+	tu.mutation.SetRemindedAt(t)
+	return tu
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableRemindedAt(t *time.Time) *TodoUpdate {
 	if t != nil {
-		tu.SetStatus(*t)
+		tu.SetRemindedAt(*t)
+	}
+	return tu
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (tu *TodoUpdate) ClearRemindedAt() *TodoUpdate {
+	tu.mutation.ClearRemindedAt()
+	return tu
+}
+
+// SetPriority sets the "priority" field.
+func (tu *TodoUpdate) SetPriority(i int) *TodoUpdate {
+	tu.mutation.ResetPriority()
+	tu.mutation.SetPriority(i)
+	return tu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillablePriority(i *int) *TodoUpdate {
+	if i != nil {
+		tu.SetPriority(*i)
+	}
+	return tu
+}
+
+// AddPriority adds i to the "priority" field.
+func (tu *TodoUpdate) AddPriority(i int) *TodoUpdate {
+	tu.mutation.AddPriority(i)
+	return tu
+}
+
+// SetPosition sets the "position" field.
+func (tu *TodoUpdate) SetPosition(s string) *TodoUpdate {
+	tu.mutation.SetPosition(s)
+	return tu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillablePosition(s *string) *TodoUpdate {
+	if s != nil {
+		tu.SetPosition(*s)
+	}
+	return tu
+}
+
+// SetVersion sets the "version" field.
+func (tu *TodoUpdate) SetVersion(i int) *TodoUpdate {
+	tu.mutation.ResetVersion()
+	tu.mutation.SetVersion(i)
+	return tu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableVersion(i *int) *TodoUpdate {
+	if i != nil {
+		tu.SetVersion(*i)
+	}
+	return tu
+}
+
+// AddVersion adds i to the "version" field.
+func (tu *TodoUpdate) AddVersion(i int) *TodoUpdate {
+	tu.mutation.AddVersion(i)
+	return tu
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (tu *TodoUpdate) SetOwnerID(id int) *TodoUpdate {
+	tu.mutation.SetOwnerID(id)
+	return tu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (tu *TodoUpdate) SetOwner(u *User) *TodoUpdate {
+	return tu.SetOwnerID(u.ID)
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tu *TodoUpdate) SetProject(p *Project) *TodoUpdate {
+	return tu.SetProjectID(p.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tu *TodoUpdate) AddTagIDs(ids ...int) *TodoUpdate {
+	tu.mutation.AddTagIDs(ids...)
+	return tu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tu *TodoUpdate) AddTags(t ...*Tag) *TodoUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddTagIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Todo entity.
+func (tu *TodoUpdate) SetParent(t *Todo) *TodoUpdate {
+	return tu.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Todo entity by IDs.
+func (tu *TodoUpdate) AddChildIDs(ids ...int) *TodoUpdate {
+	tu.mutation.AddChildIDs(ids...)
+	return tu
+}
+
+// AddChildren adds the "children" edges to the Todo entity.
+func (tu *TodoUpdate) AddChildren(t ...*Todo) *TodoUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddChildIDs(ids...)
+}
+
+// AddTransitionIDs adds the "transitions" edge to the TodoTransition entity by IDs.
+func (tu *TodoUpdate) AddTransitionIDs(ids ...int) *TodoUpdate {
+	tu.mutation.AddTransitionIDs(ids...)
+	return tu
+}
+
+// AddTransitions adds the "transitions" edges to the TodoTransition entity.
+func (tu *TodoUpdate) AddTransitions(t ...*TodoTransition) *TodoUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddTransitionIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the TodoRevision entity by IDs.
+func (tu *TodoUpdate) AddRevisionIDs(ids ...int) *TodoUpdate {
+	tu.mutation.AddRevisionIDs(ids...)
+	return tu
+}
+
+// AddRevisions adds the "revisions" edges to the TodoRevision entity.
+func (tu *TodoUpdate) AddRevisions(t ...*TodoRevision) *TodoUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddRevisionIDs(ids...)
+}
+
+// Mutation returns the TodoMutation object of the builder.
+func (tu *TodoUpdate) Mutation() *TodoMutation {
+	return tu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (tu *TodoUpdate) ClearOwner() *TodoUpdate {
+	tu.mutation.ClearOwner()
+	return tu
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (tu *TodoUpdate) ClearProject() *TodoUpdate {
+	tu.mutation.ClearProject()
+	return tu
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (tu *TodoUpdate) ClearTags() *TodoUpdate {
+	tu.mutation.ClearTags()
+	return tu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (tu *TodoUpdate) RemoveTagIDs(ids ...int) *TodoUpdate {
+	tu.mutation.RemoveTagIDs(ids...)
+	return tu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (tu *TodoUpdate) RemoveTags(t ...*Tag) *TodoUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveTagIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Todo entity.
+func (tu *TodoUpdate) ClearParent() *TodoUpdate {
+	tu.mutation.ClearParent()
+	return tu
+}
+
+// ClearChildren clears all "children" edges to the Todo entity.
+func (tu *TodoUpdate) ClearChildren() *TodoUpdate {
+	tu.mutation.ClearChildren()
+	return tu
+}
+
+// RemoveChildIDs removes the "children" edge to Todo entities by IDs.
+func (tu *TodoUpdate) RemoveChildIDs(ids ...int) *TodoUpdate {
+	tu.mutation.RemoveChildIDs(ids...)
+	return tu
+}
+
+// RemoveChildren removes "children" edges to Todo entities.
+func (tu *TodoUpdate) RemoveChildren(t ...*Todo) *TodoUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveChildIDs(ids...)
+}
+
+// ClearTransitions clears all "transitions" edges to the TodoTransition entity.
+func (tu *TodoUpdate) ClearTransitions() *TodoUpdate {
+	tu.mutation.ClearTransitions()
+	return tu
+}
+
+// RemoveTransitionIDs removes the "transitions" edge to TodoTransition entities by IDs.
+func (tu *TodoUpdate) RemoveTransitionIDs(ids ...int) *TodoUpdate {
+	tu.mutation.RemoveTransitionIDs(ids...)
+	return tu
+}
+
+// RemoveTransitions removes "transitions" edges to TodoTransition entities.
+func (tu *TodoUpdate) RemoveTransitions(t ...*TodoTransition) *TodoUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tu.RemoveTransitionIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the TodoRevision entity.
+func (tu *TodoUpdate) ClearRevisions() *TodoUpdate {
+	tu.mutation.ClearRevisions()
+	return tu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to TodoRevision entities by IDs.
+func (tu *TodoUpdate) RemoveRevisionIDs(ids ...int) *TodoUpdate {
+	tu.mutation.RemoveRevisionIDs(ids...)
 	return tu
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (tu *TodoUpdate) SetDeletedAt(t time.Time) *TodoUpdate {
-	tu.mutation.SetDeletedAt(t)
-	return tu
+// RemoveRevisions removes "revisions" edges to TodoRevision entities.
+func (tu *TodoUpdate) RemoveRevisions(t ...*TodoRevision) *TodoUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveRevisionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TodoUpdate) Save(ctx context.Context) (int, error) {
+	tu.defaults()
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TodoUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TodoUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TodoUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tu *TodoUpdate) defaults() {
+	if _, ok := tu.mutation.UpdatedAt(); !ok {
+		v := todo.UpdateDefaultUpdatedAt()
+		tu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TodoUpdate) check() error {
+	if v, ok := tu.mutation.Title(); ok {
+		if err := todo.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Todo.title": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Status(); ok {
+		if err := todo.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Todo.status": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Priority(); ok {
+		if err := todo.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Todo.priority": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Position(); ok {
+		if err := todo.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Todo.position": %w`, err)}
+		}
+	}
+	if tu.mutation.OwnerCleared() && len(tu.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Todo.owner"`)
+	}
+	return nil
+}
+
+func (tu *TodoUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todo.Table, todo.Columns, sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.UpdatedAt(); ok {
+		_spec.SetField(todo.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tu.mutation.Title(); ok {
+		_spec.SetField(todo.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Description(); ok {
+		_spec.SetField(todo.FieldDescription, field.TypeString, value)
+	}
+	if tu.mutation.DescriptionCleared() {
+		_spec.ClearField(todo.FieldDescription, field.TypeString)
+	}
+	if value, ok := tu.mutation.Status(); ok {
+		_spec.SetField(todo.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.StartedAt(); ok {
+		_spec.SetField(todo.FieldStartedAt, field.TypeTime, value)
+	}
+	if tu.mutation.StartedAtCleared() {
+		_spec.ClearField(todo.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.CompletedAt(); ok {
+		_spec.SetField(todo.FieldCompletedAt, field.TypeTime, value)
+	}
+	if tu.mutation.CompletedAtCleared() {
+		_spec.ClearField(todo.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.DeletedAt(); ok {
+		_spec.SetField(todo.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tu.mutation.DeletedAtCleared() {
+		_spec.ClearField(todo.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.AutoComplete(); ok {
+		_spec.SetField(todo.FieldAutoComplete, field.TypeBool, value)
+	}
+	if value, ok := tu.mutation.DueAt(); ok {
+		_spec.SetField(todo.FieldDueAt, field.TypeTime, value)
+	}
+	if tu.mutation.DueAtCleared() {
+		_spec.ClearField(todo.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.RemindAt(); ok {
+		_spec.SetField(todo.FieldRemindAt, field.TypeTime, value)
+	}
+	if tu.mutation.RemindAtCleared() {
+		_spec.ClearField(todo.FieldRemindAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.RemindedAt(); ok {
+		_spec.SetField(todo.FieldRemindedAt, field.TypeTime, value)
+	}
+	if tu.mutation.RemindedAtCleared() {
+		_spec.ClearField(todo.FieldRemindedAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.Priority(); ok {
+		_spec.SetField(todo.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedPriority(); ok {
+		_spec.AddField(todo.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.Position(); ok {
+		_spec.SetField(todo.FieldPosition, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Version(); ok {
+		_spec.SetField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedVersion(); ok {
+		_spec.AddField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if tu.mutation.UIDCleared() {
+		_spec.ClearField(todo.FieldUID, field.TypeString)
+	}
+	if tu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.OwnerTable,
+			Columns: []string{todo.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.OwnerTable,
+			Columns: []string{todo.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !tu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !tu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.TransitionsTable,
+			Columns: []string{todo.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todotransition.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedTransitionsIDs(); len(nodes) > 0 && !tu.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.TransitionsTable,
+			Columns: []string{todo.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todotransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.TransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.TransitionsTable,
+			Columns: []string{todo.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todotransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RevisionsTable,
+			Columns: []string{todo.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todorevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !tu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RevisionsTable,
+			Columns: []string{todo.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todorevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RevisionsTable,
+			Columns: []string{todo.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todorevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todo.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TodoUpdateOne is the builder for updating a single Todo entity.
+type TodoUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tuo *TodoUpdateOne) SetUpdatedAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetUpdatedAt(t)
+	return tuo
+}
+
+// SetTitle sets the "title" field.
+func (tuo *TodoUpdateOne) SetTitle(s string) *TodoUpdateOne {
+	tuo.mutation.SetTitle(s)
+	return tuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableTitle(s *string) *TodoUpdateOne {
+	if s != nil {
+		tuo.SetTitle(*s)
+	}
+	return tuo
+}
+
+// SetDescription sets the "description" field.
+func (tuo *TodoUpdateOne) SetDescription(s string) *TodoUpdateOne {
+	tuo.mutation.SetDescription(s)
+	return tuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableDescription(s *string) *TodoUpdateOne {
+	if s != nil {
+		tuo.SetDescription(*s)
+	}
+	return tuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (tuo *TodoUpdateOne) ClearDescription() *TodoUpdateOne {
+	tuo.mutation.ClearDescription()
+	return tuo
+}
+
+// SetStatus sets the "status" field.
+func (tuo *TodoUpdateOne) SetStatus(s string) *TodoUpdateOne {
+	tuo.mutation.SetStatus(s)
+	return tuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableStatus(s *string) *TodoUpdateOne {
+	if s != nil {
+		tuo.SetStatus(*s)
+	}
+	return tuo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (tuo *TodoUpdateOne) SetStartedAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetStartedAt(t)
+	return tuo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableStartedAt(t *time.Time) *TodoUpdateOne {
+	if t != nil {
+		tuo.SetStartedAt(*t)
+	}
+	return tuo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (tuo *TodoUpdateOne) ClearStartedAt() *TodoUpdateOne {
+	tuo.mutation.ClearStartedAt()
+	return tuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tuo *TodoUpdateOne) SetCompletedAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetCompletedAt(t)
+	return tuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableCompletedAt(t *time.Time) *TodoUpdateOne {
+	if t != nil {
+		tuo.SetCompletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (tuo *TodoUpdateOne) ClearCompletedAt() *TodoUpdateOne {
+	tuo.mutation.ClearCompletedAt()
+	return tuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tuo *TodoUpdateOne) SetDeletedAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetDeletedAt(t)
+	return tuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableDeletedAt(t *time.Time) *TodoUpdateOne {
+	if t != nil {
+		tuo.SetDeletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tuo *TodoUpdateOne) ClearDeletedAt() *TodoUpdateOne {
+	tuo.mutation.ClearDeletedAt()
+	return tuo
+}
+
+// SetUserID sets the "user_id" field.
+func (tuo *TodoUpdateOne) SetUserID(i int) *TodoUpdateOne {
+	tuo.mutation.SetUserID(i)
+	return tuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableUserID(i *int) *TodoUpdateOne {
+	if i != nil {
+		tuo.SetUserID(*i)
+	}
+	return tuo
+}
+
+// SetProjectID sets the "project_id" field.
+func (tuo *TodoUpdateOne) SetProjectID(i int) *TodoUpdateOne {
+	tuo.mutation.SetProjectID(i)
+	return tuo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableProjectID(i *int) *TodoUpdateOne {
+	if i != nil {
+		tuo.SetProjectID(*i)
+	}
+	return tuo
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (tuo *TodoUpdateOne) ClearProjectID() *TodoUpdateOne {
+	tuo.mutation.ClearProjectID()
+	return tuo
+}
+
+// SetParentID sets the "parent_id" field.
+func (tuo *TodoUpdateOne) SetParentID(i int) *TodoUpdateOne {
+	tuo.mutation.SetParentID(i)
+	return tuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableParentID(i *int) *TodoUpdateOne {
+	if i != nil {
+		tuo.SetParentID(*i)
+	}
+	return tuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tuo *TodoUpdateOne) ClearParentID() *TodoUpdateOne {
+	tuo.mutation.ClearParentID()
+	return tuo
+}
+
+// SetAutoComplete sets the "auto_complete" field.
+func (tuo *TodoUpdateOne) SetAutoComplete(b bool) *TodoUpdateOne {
+	tuo.mutation.SetAutoComplete(b)
+	return tuo
+}
+
+// SetNillableAutoComplete sets the "auto_complete" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableAutoComplete(b *bool) *TodoUpdateOne {
+	if b != nil {
+		tuo.SetAutoComplete(*b)
+	}
+	return tuo
+}
+
+// SetDueAt sets the "due_at" field.
+func (tuo *TodoUpdateOne) SetDueAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetDueAt(t)
+	return tuo
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableDueAt(t *time.Time) *TodoUpdateOne {
+	if t != nil {
+		tuo.SetDueAt(*t)
+	}
+	return tuo
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (tuo *TodoUpdateOne) ClearDueAt() *TodoUpdateOne {
+	tuo.mutation.ClearDueAt()
+	return tuo
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (tuo *TodoUpdateOne) SetRemindAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetRemindAt(t)
+	return tuo
+}
+
+// SetNillableRemindAt sets the "remind_at" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableRemindAt(t *time.Time) *TodoUpdateOne {
+	if t != nil {
+		tuo.SetRemindAt(*t)
+	}
+	return tuo
+}
+
+// ClearRemindAt clears the value of the "remind_at" field.
+func (tuo *TodoUpdateOne) ClearRemindAt() *TodoUpdateOne {
+	tuo.mutation.ClearRemindAt()
+	return tuo
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (tuo *TodoUpdateOne) SetRemindedAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetRemindedAt(t)
+	return tuo
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (tu *TodoUpdate) SetNillableDeletedAt(t *time.Time) *TodoUpdate {
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableRemindedAt(t *time.Time) *TodoUpdateOne {
 	if t != nil {
-		tu.SetDeletedAt(*t)
+		tuo.SetRemindedAt(*t)
 	}
-	return tu
+	return tuo
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (tu *TodoUpdate) ClearDeletedAt() *TodoUpdate {
-	tu.mutation.ClearDeletedAt()
-	return tu
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (tuo *TodoUpdateOne) ClearRemindedAt() *TodoUpdateOne {
+	tuo.mutation.ClearRemindedAt()
+	return tuo
 }
 
-// Mutation returns the TodoMutation object of the builder.
-func (tu *TodoUpdate) Mutation() *TodoMutation {
-	return tu.mutation
+// SetPriority sets the "priority" field.
+func (tuo *TodoUpdateOne) SetPriority(i int) *TodoUpdateOne {
+	tuo.mutation.ResetPriority()
+	tuo.mutation.SetPriority(i)
+	return tuo
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (tu *TodoUpdate) Save(ctx context.Context) (int, error) {
-	tu.defaults()
-	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillablePriority(i *int) *TodoUpdateOne {
+	if i != nil {
+		tuo.SetPriority(*i)
+	}
+	return tuo
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (tu *TodoUpdate) SaveX(ctx context.Context) int {
-	affected, err := tu.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return affected
+// AddPriority adds i to the "priority" field.
+func (tuo *TodoUpdateOne) AddPriority(i int) *TodoUpdateOne {
+	tuo.mutation.AddPriority(i)
+	return tuo
 }
 
-// Exec executes the query.
-func (tu *TodoUpdate) Exec(ctx context.Context) error {
-	_, err := tu.Save(ctx)
-	return err
+// SetPosition sets the "position" field.
+func (tuo *TodoUpdateOne) SetPosition(s string) *TodoUpdateOne {
+	tuo.mutation.SetPosition(s)
+	return tuo
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (tu *TodoUpdate) ExecX(ctx context.Context) {
-	if err := tu.Exec(ctx); err != nil {
-		panic(err)
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillablePosition(s *string) *TodoUpdateOne {
+	if s != nil {
+		tuo.SetPosition(*s)
 	}
+	return tuo
 }
 
-// defaults sets the default values of the builder before save.
-func (tu *TodoUpdate) defaults() {
-	if _, ok := tu.mutation.UpdatedAt(); !ok {
-		v := todo.UpdateDefaultUpdatedAt()
-		tu.mutation.SetUpdatedAt(v)
-	}
+// SetVersion sets the "version" field.
+func (tuo *TodoUpdateOne) SetVersion(i int) *TodoUpdateOne {
+	tuo.mutation.ResetVersion()
+	tuo.mutation.SetVersion(i)
+	return tuo
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (tu *TodoUpdate) check() error {
-	if v, ok := tu.mutation.Title(); ok {
-		if err := todo.TitleValidator(v); err != nil {
-			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Todo.title": %w`, err)}
-		}
-	}
-	if v, ok := tu.mutation.Status(); ok {
-		if err := todo.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Todo.status": %w`, err)}
-		}
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableVersion(i *int) *TodoUpdateOne {
+	if i != nil {
+		tuo.SetVersion(*i)
 	}
-	return nil
+	return tuo
 }
 
-func (tu *TodoUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := tu.check(); err != nil {
-		return n, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(todo.Table, todo.Columns, sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt))
-	if ps := tu.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := tu.mutation.UpdatedAt(); ok {
-		_spec.SetField(todo.FieldUpdatedAt, field.TypeTime, value)
-	}
-	if value, ok := tu.mutation.Title(); ok {
-		_spec.SetField(todo.FieldTitle, field.TypeString, value)
-	}
-	if value, ok := tu.mutation.Description(); ok {
-		_spec.SetField(todo.FieldDescription, field.TypeString, value)
-	}
-	if tu.mutation.DescriptionCleared() {
-		_spec.ClearField(todo.FieldDescription, field.TypeString)
-	}
-	if value, ok := tu.mutation.Status(); ok {
-		_spec.SetField(todo.FieldStatus, field.TypeEnum, value)
+// AddVersion adds i to the "version" field.
+func (tuo *TodoUpdateOne) AddVersion(i int) *TodoUpdateOne {
+	tuo.mutation.AddVersion(i)
+	return tuo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (tuo *TodoUpdateOne) SetOwnerID(id int) *TodoUpdateOne {
+	tuo.mutation.SetOwnerID(id)
+	return tuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (tuo *TodoUpdateOne) SetOwner(u *User) *TodoUpdateOne {
+	return tuo.SetOwnerID(u.ID)
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tuo *TodoUpdateOne) SetProject(p *Project) *TodoUpdateOne {
+	return tuo.SetProjectID(p.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tuo *TodoUpdateOne) AddTagIDs(ids ...int) *TodoUpdateOne {
+	tuo.mutation.AddTagIDs(ids...)
+	return tuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tuo *TodoUpdateOne) AddTags(t ...*Tag) *TodoUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	if value, ok := tu.mutation.DeletedAt(); ok {
-		_spec.SetField(todo.FieldDeletedAt, field.TypeTime, value)
+	return tuo.AddTagIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Todo entity.
+func (tuo *TodoUpdateOne) SetParent(t *Todo) *TodoUpdateOne {
+	return tuo.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Todo entity by IDs.
+func (tuo *TodoUpdateOne) AddChildIDs(ids ...int) *TodoUpdateOne {
+	tuo.mutation.AddChildIDs(ids...)
+	return tuo
+}
+
+// AddChildren adds the "children" edges to the Todo entity.
+func (tuo *TodoUpdateOne) AddChildren(t ...*Todo) *TodoUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	if tu.mutation.DeletedAtCleared() {
-		_spec.ClearField(todo.FieldDeletedAt, field.TypeTime)
+	return tuo.AddChildIDs(ids...)
+}
+
+// AddTransitionIDs adds the "transitions" edge to the TodoTransition entity by IDs.
+func (tuo *TodoUpdateOne) AddTransitionIDs(ids ...int) *TodoUpdateOne {
+	tuo.mutation.AddTransitionIDs(ids...)
+	return tuo
+}
+
+// AddTransitions adds the "transitions" edges to the TodoTransition entity.
+func (tuo *TodoUpdateOne) AddTransitions(t ...*TodoTransition) *TodoUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{todo.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return tuo.AddTransitionIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the TodoRevision entity by IDs.
+func (tuo *TodoUpdateOne) AddRevisionIDs(ids ...int) *TodoUpdateOne {
+	tuo.mutation.AddRevisionIDs(ids...)
+	return tuo
+}
+
+// AddRevisions adds the "revisions" edges to the TodoRevision entity.
+func (tuo *TodoUpdateOne) AddRevisions(t ...*TodoRevision) *TodoUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	tu.mutation.done = true
-	return n, nil
+	return tuo.AddRevisionIDs(ids...)
 }
 
-// TodoUpdateOne is the builder for updating a single Todo entity.
-type TodoUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *TodoMutation
+// Mutation returns the TodoMutation object of the builder.
+func (tuo *TodoUpdateOne) Mutation() *TodoMutation {
+	return tuo.mutation
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (tuo *TodoUpdateOne) SetUpdatedAt(t time.Time) *TodoUpdateOne {
-	tuo.mutation.SetUpdatedAt(t)
+// ClearOwner clears the "owner" edge to the User entity.
+func (tuo *TodoUpdateOne) ClearOwner() *TodoUpdateOne {
+	tuo.mutation.ClearOwner()
 	return tuo
 }
 
-// SetTitle sets the "title" field.
-func (tuo *TodoUpdateOne) SetTitle(s string) *TodoUpdateOne {
-	tuo.mutation.SetTitle(s)
+// ClearProject clears the "project" edge to the Project entity.
+func (tuo *TodoUpdateOne) ClearProject() *TodoUpdateOne {
+	tuo.mutation.ClearProject()
 	return tuo
 }
 
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (tuo *TodoUpdateOne) SetNillableTitle(s *string) *TodoUpdateOne {
-	if s != nil {
-		tuo.SetTitle(*s)
-	}
+// ClearTags clears all "tags" edges to the Tag entity.
+func (tuo *TodoUpdateOne) ClearTags() *TodoUpdateOne {
+	tuo.mutation.ClearTags()
 	return tuo
 }
 
-// SetDescription sets the "description" field.
-func (tuo *TodoUpdateOne) SetDescription(s string) *TodoUpdateOne {
-	tuo.mutation.SetDescription(s)
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (tuo *TodoUpdateOne) RemoveTagIDs(ids ...int) *TodoUpdateOne {
+	tuo.mutation.RemoveTagIDs(ids...)
 	return tuo
 }
 
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (tuo *TodoUpdateOne) SetNillableDescription(s *string) *TodoUpdateOne {
-	if s != nil {
-		tuo.SetDescription(*s)
+// RemoveTags removes "tags" edges to Tag entities.
+func (tuo *TodoUpdateOne) RemoveTags(t ...*Tag) *TodoUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tuo.RemoveTagIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Todo entity.
+func (tuo *TodoUpdateOne) ClearParent() *TodoUpdateOne {
+	tuo.mutation.ClearParent()
 	return tuo
 }
 
-// ClearDescription clears the value of the "description" field.
-func (tuo *TodoUpdateOne) ClearDescription() *TodoUpdateOne {
-	tuo.mutation.ClearDescription()
+// ClearChildren clears all "children" edges to the Todo entity.
+func (tuo *TodoUpdateOne) ClearChildren() *TodoUpdateOne {
+	tuo.mutation.ClearChildren()
 	return tuo
 }
 
-// SetStatus sets the "status" field.
-func (tuo *TodoUpdateOne) SetStatus(t todo.Status) *TodoUpdateOne {
-	tuo.mutation.SetStatus(t)
+// RemoveChildIDs removes the "children" edge to Todo entities by IDs.
+func (tuo *TodoUpdateOne) RemoveChildIDs(ids ...int) *TodoUpdateOne {
+	tuo.mutation.RemoveChildIDs(ids...)
 	return tuo
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (tuo *TodoUpdateOne) SetNillableStatus(t *todo.Status) *TodoUpdateOne {
-	if t != nil {
-		tuo.SetStatus(*t)
+// RemoveChildren removes "children" edges to Todo entities.
+func (tuo *TodoUpdateOne) RemoveChildren(t ...*Todo) *TodoUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tuo.RemoveChildIDs(ids...)
+}
+
+// ClearTransitions clears all "transitions" edges to the TodoTransition entity.
+func (tuo *TodoUpdateOne) ClearTransitions() *TodoUpdateOne {
+	tuo.mutation.ClearTransitions()
 	return tuo
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (tuo *TodoUpdateOne) SetDeletedAt(t time.Time) *TodoUpdateOne {
-	tuo.mutation.SetDeletedAt(t)
+// RemoveTransitionIDs removes the "transitions" edge to TodoTransition entities by IDs.
+func (tuo *TodoUpdateOne) RemoveTransitionIDs(ids ...int) *TodoUpdateOne {
+	tuo.mutation.RemoveTransitionIDs(ids...)
 	return tuo
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (tuo *TodoUpdateOne) SetNillableDeletedAt(t *time.Time) *TodoUpdateOne {
-	if t != nil {
-		tuo.SetDeletedAt(*t)
+// RemoveTransitions removes "transitions" edges to TodoTransition entities.
+func (tuo *TodoUpdateOne) RemoveTransitions(t ...*TodoTransition) *TodoUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tuo.RemoveTransitionIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the TodoRevision entity.
+func (tuo *TodoUpdateOne) ClearRevisions() *TodoUpdateOne {
+	tuo.mutation.ClearRevisions()
 	return tuo
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (tuo *TodoUpdateOne) ClearDeletedAt() *TodoUpdateOne {
-	tuo.mutation.ClearDeletedAt()
+// RemoveRevisionIDs removes the "revisions" edge to TodoRevision entities by IDs.
+func (tuo *TodoUpdateOne) RemoveRevisionIDs(ids ...int) *TodoUpdateOne {
+	tuo.mutation.RemoveRevisionIDs(ids...)
 	return tuo
 }
 
-// Mutation returns the TodoMutation object of the builder.
-func (tuo *TodoUpdateOne) Mutation() *TodoMutation {
-	return tuo.mutation
+// RemoveRevisions removes "revisions" edges to TodoRevision entities.
+func (tuo *TodoUpdateOne) RemoveRevisions(t ...*TodoRevision) *TodoUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveRevisionIDs(ids...)
 }
 
 // Where appends a list predicates to the TodoUpdate builder.
@@ -351,6 +1501,19 @@ func (tuo *TodoUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Todo.status": %w`, err)}
 		}
 	}
+	if v, ok := tuo.mutation.Priority(); ok {
+		if err := todo.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Todo.priority": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Position(); ok {
+		if err := todo.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Todo.position": %w`, err)}
+		}
+	}
+	if tuo.mutation.OwnerCleared() && len(tuo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Todo.owner"`)
+	}
 	return nil
 }
 
@@ -396,7 +1559,19 @@ func (tuo *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error)
 		_spec.ClearField(todo.FieldDescription, field.TypeString)
 	}
 	if value, ok := tuo.mutation.Status(); ok {
-		_spec.SetField(todo.FieldStatus, field.TypeEnum, value)
+		_spec.SetField(todo.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.StartedAt(); ok {
+		_spec.SetField(todo.FieldStartedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.StartedAtCleared() {
+		_spec.ClearField(todo.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.CompletedAt(); ok {
+		_spec.SetField(todo.FieldCompletedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(todo.FieldCompletedAt, field.TypeTime)
 	}
 	if value, ok := tuo.mutation.DeletedAt(); ok {
 		_spec.SetField(todo.FieldDeletedAt, field.TypeTime, value)
@@ -404,6 +1579,312 @@ func (tuo *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error)
 	if tuo.mutation.DeletedAtCleared() {
 		_spec.ClearField(todo.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := tuo.mutation.AutoComplete(); ok {
+		_spec.SetField(todo.FieldAutoComplete, field.TypeBool, value)
+	}
+	if value, ok := tuo.mutation.DueAt(); ok {
+		_spec.SetField(todo.FieldDueAt, field.TypeTime, value)
+	}
+	if tuo.mutation.DueAtCleared() {
+		_spec.ClearField(todo.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.RemindAt(); ok {
+		_spec.SetField(todo.FieldRemindAt, field.TypeTime, value)
+	}
+	if tuo.mutation.RemindAtCleared() {
+		_spec.ClearField(todo.FieldRemindAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.RemindedAt(); ok {
+		_spec.SetField(todo.FieldRemindedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.RemindedAtCleared() {
+		_spec.ClearField(todo.FieldRemindedAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.Priority(); ok {
+		_spec.SetField(todo.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedPriority(); ok {
+		_spec.AddField(todo.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.Position(); ok {
+		_spec.SetField(todo.FieldPosition, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Version(); ok {
+		_spec.SetField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedVersion(); ok {
+		_spec.AddField(todo.FieldVersion, field.TypeInt, value)
+	}
+	if tuo.mutation.UIDCleared() {
+		_spec.ClearField(todo.FieldUID, field.TypeString)
+	}
+	if tuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.OwnerTable,
+			Columns: []string{todo.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.OwnerTable,
+			Columns: []string{todo.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ProjectTable,
+			Columns: []string{todo.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !tuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todo.TagsTable,
+			Columns: todo.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todo.ParentTable,
+			Columns: []string{todo.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !tuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.ChildrenTable,
+			Columns: []string{todo.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.TransitionsTable,
+			Columns: []string{todo.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todotransition.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedTransitionsIDs(); len(nodes) > 0 && !tuo.mutation.TransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.TransitionsTable,
+			Columns: []string{todo.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todotransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.TransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.TransitionsTable,
+			Columns: []string{todo.TransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todotransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RevisionsTable,
+			Columns: []string{todo.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todorevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !tuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RevisionsTable,
+			Columns: []string{todo.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todorevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todo.RevisionsTable,
+			Columns: []string{todo.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todorevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Todo{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
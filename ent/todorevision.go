@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"todo-api-golang/ent/schema"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/todorevision"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TodoRevision is the model entity for the TodoRevision schema.
+type TodoRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TodoID holds the value of the "todo_id" field.
+	TodoID int `json:"todo_id,omitempty"`
+	// The user who made the change. Empty for changes made by background workers.
+	UserID *int `json:"user_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action todorevision.Action `json:"action,omitempty"`
+	// The changed fields, keyed by their JSON name.
+	Changes map[string]schema.FieldChange `json:"changes,omitempty"`
+	// Snapshot holds the value of the "snapshot" field.
+	Snapshot schema.TodoSnapshot `json:"snapshot,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoRevisionQuery when eager-loading is set.
+	Edges        TodoRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TodoRevisionEdges holds the relations/edges for other nodes in the graph.
+type TodoRevisionEdges struct {
+	// Todo holds the value of the todo edge.
+	Todo *Todo `json:"todo,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// TodoOrErr returns the Todo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoRevisionEdges) TodoOrErr() (*Todo, error) {
+	if e.Todo != nil {
+		return e.Todo, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: todo.Label}
+	}
+	return nil, &NotLoadedError{edge: "todo"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TodoRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case todorevision.FieldChanges, todorevision.FieldSnapshot:
+			values[i] = new([]byte)
+		case todorevision.FieldID, todorevision.FieldTodoID, todorevision.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case todorevision.FieldAction:
+			values[i] = new(sql.NullString)
+		case todorevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TodoRevision fields.
+func (tr *TodoRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case todorevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tr.ID = int(value.Int64)
+		case todorevision.FieldTodoID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field todo_id", values[i])
+			} else if value.Valid {
+				tr.TodoID = int(value.Int64)
+			}
+		case todorevision.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				tr.UserID = new(int)
+				*tr.UserID = int(value.Int64)
+			}
+		case todorevision.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				tr.Action = todorevision.Action(value.String)
+			}
+		case todorevision.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tr.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case todorevision.FieldSnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tr.Snapshot); err != nil {
+					return fmt.Errorf("unmarshal field snapshot: %w", err)
+				}
+			}
+		case todorevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tr.CreatedAt = value.Time
+			}
+		default:
+			tr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TodoRevision.
+// This includes values selected through modifiers, order, etc.
+func (tr *TodoRevision) Value(name string) (ent.Value, error) {
+	return tr.selectValues.Get(name)
+}
+
+// QueryTodo queries the "todo" edge of the TodoRevision entity.
+func (tr *TodoRevision) QueryTodo() *TodoQuery {
+	return NewTodoRevisionClient(tr.config).QueryTodo(tr)
+}
+
+// Update returns a builder for updating this TodoRevision.
+// Note that you need to call TodoRevision.Unwrap() before calling this method if this TodoRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tr *TodoRevision) Update() *TodoRevisionUpdateOne {
+	return NewTodoRevisionClient(tr.config).UpdateOne(tr)
+}
+
+// Unwrap unwraps the TodoRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tr *TodoRevision) Unwrap() *TodoRevision {
+	_tx, ok := tr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TodoRevision is not a transactional entity")
+	}
+	tr.config.driver = _tx.drv
+	return tr
+}
+
+// String implements the fmt.Stringer.
+func (tr *TodoRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("TodoRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tr.ID))
+	builder.WriteString("todo_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.TodoID))
+	builder.WriteString(", ")
+	if v := tr.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Action))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Snapshot))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TodoRevisions is a parsable slice of TodoRevision.
+type TodoRevisions []*TodoRevision
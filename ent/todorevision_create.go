@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-api-golang/ent/schema"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/todorevision"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoRevisionCreate is the builder for creating a TodoRevision entity.
+type TodoRevisionCreate struct {
+	config
+	mutation *TodoRevisionMutation
+	hooks    []Hook
+}
+
+// SetTodoID sets the "todo_id" field.
+func (trc *TodoRevisionCreate) SetTodoID(i int) *TodoRevisionCreate {
+	trc.mutation.SetTodoID(i)
+	return trc
+}
+
+// SetUserID sets the "user_id" field.
+func (trc *TodoRevisionCreate) SetUserID(i int) *TodoRevisionCreate {
+	trc.mutation.SetUserID(i)
+	return trc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (trc *TodoRevisionCreate) SetNillableUserID(i *int) *TodoRevisionCreate {
+	if i != nil {
+		trc.SetUserID(*i)
+	}
+	return trc
+}
+
+// SetAction sets the "action" field.
+func (trc *TodoRevisionCreate) SetAction(t todorevision.Action) *TodoRevisionCreate {
+	trc.mutation.SetAction(t)
+	return trc
+}
+
+// SetChanges sets the "changes" field.
+func (trc *TodoRevisionCreate) SetChanges(mc map[string]schema.FieldChange) *TodoRevisionCreate {
+	trc.mutation.SetChanges(mc)
+	return trc
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (trc *TodoRevisionCreate) SetSnapshot(ss schema.TodoSnapshot) *TodoRevisionCreate {
+	trc.mutation.SetSnapshot(ss)
+	return trc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (trc *TodoRevisionCreate) SetCreatedAt(t time.Time) *TodoRevisionCreate {
+	trc.mutation.SetCreatedAt(t)
+	return trc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (trc *TodoRevisionCreate) SetNillableCreatedAt(t *time.Time) *TodoRevisionCreate {
+	if t != nil {
+		trc.SetCreatedAt(*t)
+	}
+	return trc
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (trc *TodoRevisionCreate) SetTodo(t *Todo) *TodoRevisionCreate {
+	return trc.SetTodoID(t.ID)
+}
+
+// Mutation returns the TodoRevisionMutation object of the builder.
+func (trc *TodoRevisionCreate) Mutation() *TodoRevisionMutation {
+	return trc.mutation
+}
+
+// Save creates the TodoRevision in the database.
+func (trc *TodoRevisionCreate) Save(ctx context.Context) (*TodoRevision, error) {
+	trc.defaults()
+	return withHooks(ctx, trc.sqlSave, trc.mutation, trc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (trc *TodoRevisionCreate) SaveX(ctx context.Context) *TodoRevision {
+	v, err := trc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trc *TodoRevisionCreate) Exec(ctx context.Context) error {
+	_, err := trc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trc *TodoRevisionCreate) ExecX(ctx context.Context) {
+	if err := trc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trc *TodoRevisionCreate) defaults() {
+	if _, ok := trc.mutation.CreatedAt(); !ok {
+		v := todorevision.DefaultCreatedAt()
+		trc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trc *TodoRevisionCreate) check() error {
+	if _, ok := trc.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo_id", err: errors.New(`ent: missing required field "TodoRevision.todo_id"`)}
+	}
+	if _, ok := trc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "TodoRevision.action"`)}
+	}
+	if v, ok := trc.mutation.Action(); ok {
+		if err := todorevision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "TodoRevision.action": %w`, err)}
+		}
+	}
+	if _, ok := trc.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`ent: missing required field "TodoRevision.changes"`)}
+	}
+	if _, ok := trc.mutation.Snapshot(); !ok {
+		return &ValidationError{Name: "snapshot", err: errors.New(`ent: missing required field "TodoRevision.snapshot"`)}
+	}
+	if _, ok := trc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TodoRevision.created_at"`)}
+	}
+	if len(trc.mutation.TodoIDs()) == 0 {
+		return &ValidationError{Name: "todo", err: errors.New(`ent: missing required edge "TodoRevision.todo"`)}
+	}
+	return nil
+}
+
+func (trc *TodoRevisionCreate) sqlSave(ctx context.Context) (*TodoRevision, error) {
+	if err := trc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := trc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, trc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	trc.mutation.id = &_node.ID
+	trc.mutation.done = true
+	return _node, nil
+}
+
+func (trc *TodoRevisionCreate) createSpec() (*TodoRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TodoRevision{config: trc.config}
+		_spec = sqlgraph.NewCreateSpec(todorevision.Table, sqlgraph.NewFieldSpec(todorevision.FieldID, field.TypeInt))
+	)
+	if value, ok := trc.mutation.UserID(); ok {
+		_spec.SetField(todorevision.FieldUserID, field.TypeInt, value)
+		_node.UserID = &value
+	}
+	if value, ok := trc.mutation.Action(); ok {
+		_spec.SetField(todorevision.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := trc.mutation.Changes(); ok {
+		_spec.SetField(todorevision.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := trc.mutation.Snapshot(); ok {
+		_spec.SetField(todorevision.FieldSnapshot, field.TypeJSON, value)
+		_node.Snapshot = value
+	}
+	if value, ok := trc.mutation.CreatedAt(); ok {
+		_spec.SetField(todorevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := trc.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todorevision.TodoTable,
+			Columns: []string{todorevision.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TodoID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TodoRevisionCreateBulk is the builder for creating many TodoRevision entities in bulk.
+type TodoRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*TodoRevisionCreate
+}
+
+// Save creates the TodoRevision entities in the database.
+func (trcb *TodoRevisionCreateBulk) Save(ctx context.Context) ([]*TodoRevision, error) {
+	if trcb.err != nil {
+		return nil, trcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(trcb.builders))
+	nodes := make([]*TodoRevision, len(trcb.builders))
+	mutators := make([]Mutator, len(trcb.builders))
+	for i := range trcb.builders {
+		func(i int, root context.Context) {
+			builder := trcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TodoRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, trcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, trcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, trcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trcb *TodoRevisionCreateBulk) SaveX(ctx context.Context) []*TodoRevision {
+	v, err := trcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trcb *TodoRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := trcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcb *TodoRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := trcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
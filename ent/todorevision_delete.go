@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"todo-api-golang/ent/predicate"
+	"todo-api-golang/ent/todorevision"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoRevisionDelete is the builder for deleting a TodoRevision entity.
+type TodoRevisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *TodoRevisionMutation
+}
+
+// Where appends a list predicates to the TodoRevisionDelete builder.
+func (trd *TodoRevisionDelete) Where(ps ...predicate.TodoRevision) *TodoRevisionDelete {
+	trd.mutation.Where(ps...)
+	return trd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (trd *TodoRevisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, trd.sqlExec, trd.mutation, trd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trd *TodoRevisionDelete) ExecX(ctx context.Context) int {
+	n, err := trd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (trd *TodoRevisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(todorevision.Table, sqlgraph.NewFieldSpec(todorevision.FieldID, field.TypeInt))
+	if ps := trd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, trd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	trd.mutation.done = true
+	return affected, err
+}
+
+// TodoRevisionDeleteOne is the builder for deleting a single TodoRevision entity.
+type TodoRevisionDeleteOne struct {
+	trd *TodoRevisionDelete
+}
+
+// Where appends a list predicates to the TodoRevisionDelete builder.
+func (trdo *TodoRevisionDeleteOne) Where(ps ...predicate.TodoRevision) *TodoRevisionDeleteOne {
+	trdo.trd.mutation.Where(ps...)
+	return trdo
+}
+
+// Exec executes the deletion query.
+func (trdo *TodoRevisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := trdo.trd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{todorevision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trdo *TodoRevisionDeleteOne) ExecX(ctx context.Context) {
+	if err := trdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
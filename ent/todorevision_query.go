@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"todo-api-golang/ent/predicate"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/todorevision"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoRevisionQuery is the builder for querying TodoRevision entities.
+type TodoRevisionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []todorevision.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TodoRevision
+	withTodo   *TodoQuery
+	loadTotal  []func(context.Context, []*TodoRevision) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TodoRevisionQuery builder.
+func (trq *TodoRevisionQuery) Where(ps ...predicate.TodoRevision) *TodoRevisionQuery {
+	trq.predicates = append(trq.predicates, ps...)
+	return trq
+}
+
+// Limit the number of records to be returned by this query.
+func (trq *TodoRevisionQuery) Limit(limit int) *TodoRevisionQuery {
+	trq.ctx.Limit = &limit
+	return trq
+}
+
+// Offset to start from.
+func (trq *TodoRevisionQuery) Offset(offset int) *TodoRevisionQuery {
+	trq.ctx.Offset = &offset
+	return trq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (trq *TodoRevisionQuery) Unique(unique bool) *TodoRevisionQuery {
+	trq.ctx.Unique = &unique
+	return trq
+}
+
+// Order specifies how the records should be ordered.
+func (trq *TodoRevisionQuery) Order(o ...todorevision.OrderOption) *TodoRevisionQuery {
+	trq.order = append(trq.order, o...)
+	return trq
+}
+
+// QueryTodo chains the current query on the "todo" edge.
+func (trq *TodoRevisionQuery) QueryTodo() *TodoQuery {
+	query := (&TodoClient{config: trq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := trq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := trq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todorevision.Table, todorevision.FieldID, selector),
+			sqlgraph.To(todo.Table, todo.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todorevision.TodoTable, todorevision.TodoColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(trq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TodoRevision entity from the query.
+// Returns a *NotFoundError when no TodoRevision was found.
+func (trq *TodoRevisionQuery) First(ctx context.Context) (*TodoRevision, error) {
+	nodes, err := trq.Limit(1).All(setContextOp(ctx, trq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{todorevision.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (trq *TodoRevisionQuery) FirstX(ctx context.Context) *TodoRevision {
+	node, err := trq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TodoRevision ID from the query.
+// Returns a *NotFoundError when no TodoRevision ID was found.
+func (trq *TodoRevisionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = trq.Limit(1).IDs(setContextOp(ctx, trq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{todorevision.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (trq *TodoRevisionQuery) FirstIDX(ctx context.Context) int {
+	id, err := trq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TodoRevision entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TodoRevision entity is found.
+// Returns a *NotFoundError when no TodoRevision entities are found.
+func (trq *TodoRevisionQuery) Only(ctx context.Context) (*TodoRevision, error) {
+	nodes, err := trq.Limit(2).All(setContextOp(ctx, trq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{todorevision.Label}
+	default:
+		return nil, &NotSingularError{todorevision.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (trq *TodoRevisionQuery) OnlyX(ctx context.Context) *TodoRevision {
+	node, err := trq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TodoRevision ID in the query.
+// Returns a *NotSingularError when more than one TodoRevision ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (trq *TodoRevisionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = trq.Limit(2).IDs(setContextOp(ctx, trq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{todorevision.Label}
+	default:
+		err = &NotSingularError{todorevision.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (trq *TodoRevisionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := trq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TodoRevisions.
+func (trq *TodoRevisionQuery) All(ctx context.Context) ([]*TodoRevision, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryAll)
+	if err := trq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TodoRevision, *TodoRevisionQuery]()
+	return withInterceptors[[]*TodoRevision](ctx, trq, qr, trq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (trq *TodoRevisionQuery) AllX(ctx context.Context) []*TodoRevision {
+	nodes, err := trq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TodoRevision IDs.
+func (trq *TodoRevisionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if trq.ctx.Unique == nil && trq.path != nil {
+		trq.Unique(true)
+	}
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryIDs)
+	if err = trq.Select(todorevision.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (trq *TodoRevisionQuery) IDsX(ctx context.Context) []int {
+	ids, err := trq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (trq *TodoRevisionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryCount)
+	if err := trq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, trq, querierCount[*TodoRevisionQuery](), trq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (trq *TodoRevisionQuery) CountX(ctx context.Context) int {
+	count, err := trq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (trq *TodoRevisionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryExist)
+	switch _, err := trq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (trq *TodoRevisionQuery) ExistX(ctx context.Context) bool {
+	exist, err := trq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TodoRevisionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (trq *TodoRevisionQuery) Clone() *TodoRevisionQuery {
+	if trq == nil {
+		return nil
+	}
+	return &TodoRevisionQuery{
+		config:     trq.config,
+		ctx:        trq.ctx.Clone(),
+		order:      append([]todorevision.OrderOption{}, trq.order...),
+		inters:     append([]Interceptor{}, trq.inters...),
+		predicates: append([]predicate.TodoRevision{}, trq.predicates...),
+		withTodo:   trq.withTodo.Clone(),
+		// clone intermediate query.
+		sql:  trq.sql.Clone(),
+		path: trq.path,
+	}
+}
+
+// WithTodo tells the query-builder to eager-load the nodes that are connected to
+// the "todo" edge. The optional arguments are used to configure the query builder of the edge.
+func (trq *TodoRevisionQuery) WithTodo(opts ...func(*TodoQuery)) *TodoRevisionQuery {
+	query := (&TodoClient{config: trq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	trq.withTodo = query
+	return trq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TodoID int `json:"todo_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TodoRevision.Query().
+//		GroupBy(todorevision.FieldTodoID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (trq *TodoRevisionQuery) GroupBy(field string, fields ...string) *TodoRevisionGroupBy {
+	trq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TodoRevisionGroupBy{build: trq}
+	grbuild.flds = &trq.ctx.Fields
+	grbuild.label = todorevision.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TodoID int `json:"todo_id,omitempty"`
+//	}
+//
+//	client.TodoRevision.Query().
+//		Select(todorevision.FieldTodoID).
+//		Scan(ctx, &v)
+func (trq *TodoRevisionQuery) Select(fields ...string) *TodoRevisionSelect {
+	trq.ctx.Fields = append(trq.ctx.Fields, fields...)
+	sbuild := &TodoRevisionSelect{TodoRevisionQuery: trq}
+	sbuild.label = todorevision.Label
+	sbuild.flds, sbuild.scan = &trq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TodoRevisionSelect configured with the given aggregations.
+func (trq *TodoRevisionQuery) Aggregate(fns ...AggregateFunc) *TodoRevisionSelect {
+	return trq.Select().Aggregate(fns...)
+}
+
+func (trq *TodoRevisionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range trq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, trq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range trq.ctx.Fields {
+		if !todorevision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if trq.path != nil {
+		prev, err := trq.path(ctx)
+		if err != nil {
+			return err
+		}
+		trq.sql = prev
+	}
+	return nil
+}
+
+func (trq *TodoRevisionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TodoRevision, error) {
+	var (
+		nodes       = []*TodoRevision{}
+		_spec       = trq.querySpec()
+		loadedTypes = [1]bool{
+			trq.withTodo != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TodoRevision).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TodoRevision{config: trq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(trq.modifiers) > 0 {
+		_spec.Modifiers = trq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, trq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := trq.withTodo; query != nil {
+		if err := trq.loadTodo(ctx, query, nodes, nil,
+			func(n *TodoRevision, e *Todo) { n.Edges.Todo = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range trq.loadTotal {
+		if err := trq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (trq *TodoRevisionQuery) loadTodo(ctx context.Context, query *TodoQuery, nodes []*TodoRevision, init func(*TodoRevision), assign func(*TodoRevision, *Todo)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TodoRevision)
+	for i := range nodes {
+		fk := nodes[i].TodoID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(todo.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "todo_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (trq *TodoRevisionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := trq.querySpec()
+	if len(trq.modifiers) > 0 {
+		_spec.Modifiers = trq.modifiers
+	}
+	_spec.Node.Columns = trq.ctx.Fields
+	if len(trq.ctx.Fields) > 0 {
+		_spec.Unique = trq.ctx.Unique != nil && *trq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, trq.driver, _spec)
+}
+
+func (trq *TodoRevisionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(todorevision.Table, todorevision.Columns, sqlgraph.NewFieldSpec(todorevision.FieldID, field.TypeInt))
+	_spec.From = trq.sql
+	if unique := trq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if trq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := trq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todorevision.FieldID)
+		for i := range fields {
+			if fields[i] != todorevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if trq.withTodo != nil {
+			_spec.Node.AddColumnOnce(todorevision.FieldTodoID)
+		}
+	}
+	if ps := trq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := trq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := trq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := trq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (trq *TodoRevisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(trq.driver.Dialect())
+	t1 := builder.Table(todorevision.Table)
+	columns := trq.ctx.Fields
+	if len(columns) == 0 {
+		columns = todorevision.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if trq.sql != nil {
+		selector = trq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if trq.ctx.Unique != nil && *trq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range trq.modifiers {
+		m(selector)
+	}
+	for _, p := range trq.predicates {
+		p(selector)
+	}
+	for _, p := range trq.order {
+		p(selector)
+	}
+	if offset := trq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := trq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (trq *TodoRevisionQuery) ForUpdate(opts ...sql.LockOption) *TodoRevisionQuery {
+	if trq.driver.Dialect() == dialect.Postgres {
+		trq.Unique(false)
+	}
+	trq.modifiers = append(trq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return trq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (trq *TodoRevisionQuery) ForShare(opts ...sql.LockOption) *TodoRevisionQuery {
+	if trq.driver.Dialect() == dialect.Postgres {
+		trq.Unique(false)
+	}
+	trq.modifiers = append(trq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return trq
+}
+
+// TodoRevisionGroupBy is the group-by builder for TodoRevision entities.
+type TodoRevisionGroupBy struct {
+	selector
+	build *TodoRevisionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (trgb *TodoRevisionGroupBy) Aggregate(fns ...AggregateFunc) *TodoRevisionGroupBy {
+	trgb.fns = append(trgb.fns, fns...)
+	return trgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trgb *TodoRevisionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trgb.build.ctx, ent.OpQueryGroupBy)
+	if err := trgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TodoRevisionQuery, *TodoRevisionGroupBy](ctx, trgb.build, trgb, trgb.build.inters, v)
+}
+
+func (trgb *TodoRevisionGroupBy) sqlScan(ctx context.Context, root *TodoRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(trgb.fns))
+	for _, fn := range trgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*trgb.flds)+len(trgb.fns))
+		for _, f := range *trgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*trgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TodoRevisionSelect is the builder for selecting fields of TodoRevision entities.
+type TodoRevisionSelect struct {
+	*TodoRevisionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (trs *TodoRevisionSelect) Aggregate(fns ...AggregateFunc) *TodoRevisionSelect {
+	trs.fns = append(trs.fns, fns...)
+	return trs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trs *TodoRevisionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trs.ctx, ent.OpQuerySelect)
+	if err := trs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TodoRevisionQuery, *TodoRevisionSelect](ctx, trs.TodoRevisionQuery, trs, trs.inters, v)
+}
+
+func (trs *TodoRevisionSelect) sqlScan(ctx context.Context, root *TodoRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(trs.fns))
+	for _, fn := range trs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*trs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"todo-api-golang/ent/predicate"
+	"todo-api-golang/ent/todorevision"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoRevisionUpdate is the builder for updating TodoRevision entities.
+type TodoRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TodoRevisionMutation
+}
+
+// Where appends a list predicates to the TodoRevisionUpdate builder.
+func (tru *TodoRevisionUpdate) Where(ps ...predicate.TodoRevision) *TodoRevisionUpdate {
+	tru.mutation.Where(ps...)
+	return tru
+}
+
+// Mutation returns the TodoRevisionMutation object of the builder.
+func (tru *TodoRevisionUpdate) Mutation() *TodoRevisionMutation {
+	return tru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tru *TodoRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tru.sqlSave, tru.mutation, tru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tru *TodoRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := tru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tru *TodoRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := tru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tru *TodoRevisionUpdate) ExecX(ctx context.Context) {
+	if err := tru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tru *TodoRevisionUpdate) check() error {
+	if tru.mutation.TodoCleared() && len(tru.mutation.TodoIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoRevision.todo"`)
+	}
+	return nil
+}
+
+func (tru *TodoRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todorevision.Table, todorevision.Columns, sqlgraph.NewFieldSpec(todorevision.FieldID, field.TypeInt))
+	if ps := tru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if tru.mutation.UserIDCleared() {
+		_spec.ClearField(todorevision.FieldUserID, field.TypeInt)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todorevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tru.mutation.done = true
+	return n, nil
+}
+
+// TodoRevisionUpdateOne is the builder for updating a single TodoRevision entity.
+type TodoRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoRevisionMutation
+}
+
+// Mutation returns the TodoRevisionMutation object of the builder.
+func (truo *TodoRevisionUpdateOne) Mutation() *TodoRevisionMutation {
+	return truo.mutation
+}
+
+// Where appends a list predicates to the TodoRevisionUpdate builder.
+func (truo *TodoRevisionUpdateOne) Where(ps ...predicate.TodoRevision) *TodoRevisionUpdateOne {
+	truo.mutation.Where(ps...)
+	return truo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (truo *TodoRevisionUpdateOne) Select(field string, fields ...string) *TodoRevisionUpdateOne {
+	truo.fields = append([]string{field}, fields...)
+	return truo
+}
+
+// Save executes the query and returns the updated TodoRevision entity.
+func (truo *TodoRevisionUpdateOne) Save(ctx context.Context) (*TodoRevision, error) {
+	return withHooks(ctx, truo.sqlSave, truo.mutation, truo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (truo *TodoRevisionUpdateOne) SaveX(ctx context.Context) *TodoRevision {
+	node, err := truo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (truo *TodoRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := truo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (truo *TodoRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := truo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (truo *TodoRevisionUpdateOne) check() error {
+	if truo.mutation.TodoCleared() && len(truo.mutation.TodoIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoRevision.todo"`)
+	}
+	return nil
+}
+
+func (truo *TodoRevisionUpdateOne) sqlSave(ctx context.Context) (_node *TodoRevision, err error) {
+	if err := truo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todorevision.Table, todorevision.Columns, sqlgraph.NewFieldSpec(todorevision.FieldID, field.TypeInt))
+	id, ok := truo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TodoRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := truo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todorevision.FieldID)
+		for _, f := range fields {
+			if !todorevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != todorevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := truo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if truo.mutation.UserIDCleared() {
+		_spec.ClearField(todorevision.FieldUserID, field.TypeInt)
+	}
+	_node = &TodoRevision{config: truo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, truo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todorevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	truo.mutation.done = true
+	return _node, nil
+}
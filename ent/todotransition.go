@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/todotransition"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TodoTransition is the model entity for the TodoTransition schema.
+type TodoTransition struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TodoID holds the value of the "todo_id" field.
+	TodoID int `json:"todo_id,omitempty"`
+	// Empty for the initial status of a new todo.
+	FromStatus *string `json:"from_status,omitempty"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus string `json:"to_status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoTransitionQuery when eager-loading is set.
+	Edges        TodoTransitionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TodoTransitionEdges holds the relations/edges for other nodes in the graph.
+type TodoTransitionEdges struct {
+	// Todo holds the value of the todo edge.
+	Todo *Todo `json:"todo,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// TodoOrErr returns the Todo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoTransitionEdges) TodoOrErr() (*Todo, error) {
+	if e.Todo != nil {
+		return e.Todo, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: todo.Label}
+	}
+	return nil, &NotLoadedError{edge: "todo"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TodoTransition) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case todotransition.FieldID, todotransition.FieldTodoID:
+			values[i] = new(sql.NullInt64)
+		case todotransition.FieldFromStatus, todotransition.FieldToStatus:
+			values[i] = new(sql.NullString)
+		case todotransition.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TodoTransition fields.
+func (tt *TodoTransition) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case todotransition.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tt.ID = int(value.Int64)
+		case todotransition.FieldTodoID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field todo_id", values[i])
+			} else if value.Valid {
+				tt.TodoID = int(value.Int64)
+			}
+		case todotransition.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				tt.FromStatus = new(string)
+				*tt.FromStatus = value.String
+			}
+		case todotransition.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				tt.ToStatus = value.String
+			}
+		case todotransition.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tt.CreatedAt = value.Time
+			}
+		default:
+			tt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TodoTransition.
+// This includes values selected through modifiers, order, etc.
+func (tt *TodoTransition) Value(name string) (ent.Value, error) {
+	return tt.selectValues.Get(name)
+}
+
+// QueryTodo queries the "todo" edge of the TodoTransition entity.
+func (tt *TodoTransition) QueryTodo() *TodoQuery {
+	return NewTodoTransitionClient(tt.config).QueryTodo(tt)
+}
+
+// Update returns a builder for updating this TodoTransition.
+// Note that you need to call TodoTransition.Unwrap() before calling this method if this TodoTransition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tt *TodoTransition) Update() *TodoTransitionUpdateOne {
+	return NewTodoTransitionClient(tt.config).UpdateOne(tt)
+}
+
+// Unwrap unwraps the TodoTransition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tt *TodoTransition) Unwrap() *TodoTransition {
+	_tx, ok := tt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TodoTransition is not a transactional entity")
+	}
+	tt.config.driver = _tx.drv
+	return tt
+}
+
+// String implements the fmt.Stringer.
+func (tt *TodoTransition) String() string {
+	var builder strings.Builder
+	builder.WriteString("TodoTransition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tt.ID))
+	builder.WriteString("todo_id=")
+	builder.WriteString(fmt.Sprintf("%v", tt.TodoID))
+	builder.WriteString(", ")
+	if v := tt.FromStatus; v != nil {
+		builder.WriteString("from_status=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(tt.ToStatus)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tt.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TodoTransitions is a parsable slice of TodoTransition.
+type TodoTransitions []*TodoTransition
@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/todotransition"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoTransitionCreate is the builder for creating a TodoTransition entity.
+type TodoTransitionCreate struct {
+	config
+	mutation *TodoTransitionMutation
+	hooks    []Hook
+}
+
+// SetTodoID sets the "todo_id" field.
+func (ttc *TodoTransitionCreate) SetTodoID(i int) *TodoTransitionCreate {
+	ttc.mutation.SetTodoID(i)
+	return ttc
+}
+
+// SetFromStatus sets the "from_status" field.
+func (ttc *TodoTransitionCreate) SetFromStatus(s string) *TodoTransitionCreate {
+	ttc.mutation.SetFromStatus(s)
+	return ttc
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (ttc *TodoTransitionCreate) SetNillableFromStatus(s *string) *TodoTransitionCreate {
+	if s != nil {
+		ttc.SetFromStatus(*s)
+	}
+	return ttc
+}
+
+// SetToStatus sets the "to_status" field.
+func (ttc *TodoTransitionCreate) SetToStatus(s string) *TodoTransitionCreate {
+	ttc.mutation.SetToStatus(s)
+	return ttc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ttc *TodoTransitionCreate) SetCreatedAt(t time.Time) *TodoTransitionCreate {
+	ttc.mutation.SetCreatedAt(t)
+	return ttc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ttc *TodoTransitionCreate) SetNillableCreatedAt(t *time.Time) *TodoTransitionCreate {
+	if t != nil {
+		ttc.SetCreatedAt(*t)
+	}
+	return ttc
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (ttc *TodoTransitionCreate) SetTodo(t *Todo) *TodoTransitionCreate {
+	return ttc.SetTodoID(t.ID)
+}
+
+// Mutation returns the TodoTransitionMutation object of the builder.
+func (ttc *TodoTransitionCreate) Mutation() *TodoTransitionMutation {
+	return ttc.mutation
+}
+
+// Save creates the TodoTransition in the database.
+func (ttc *TodoTransitionCreate) Save(ctx context.Context) (*TodoTransition, error) {
+	ttc.defaults()
+	return withHooks(ctx, ttc.sqlSave, ttc.mutation, ttc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ttc *TodoTransitionCreate) SaveX(ctx context.Context) *TodoTransition {
+	v, err := ttc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ttc *TodoTransitionCreate) Exec(ctx context.Context) error {
+	_, err := ttc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttc *TodoTransitionCreate) ExecX(ctx context.Context) {
+	if err := ttc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttc *TodoTransitionCreate) defaults() {
+	if _, ok := ttc.mutation.CreatedAt(); !ok {
+		v := todotransition.DefaultCreatedAt()
+		ttc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ttc *TodoTransitionCreate) check() error {
+	if _, ok := ttc.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo_id", err: errors.New(`ent: missing required field "TodoTransition.todo_id"`)}
+	}
+	if _, ok := ttc.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "TodoTransition.to_status"`)}
+	}
+	if v, ok := ttc.mutation.ToStatus(); ok {
+		if err := todotransition.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "TodoTransition.to_status": %w`, err)}
+		}
+	}
+	if _, ok := ttc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TodoTransition.created_at"`)}
+	}
+	if len(ttc.mutation.TodoIDs()) == 0 {
+		return &ValidationError{Name: "todo", err: errors.New(`ent: missing required edge "TodoTransition.todo"`)}
+	}
+	return nil
+}
+
+func (ttc *TodoTransitionCreate) sqlSave(ctx context.Context) (*TodoTransition, error) {
+	if err := ttc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ttc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ttc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ttc.mutation.id = &_node.ID
+	ttc.mutation.done = true
+	return _node, nil
+}
+
+func (ttc *TodoTransitionCreate) createSpec() (*TodoTransition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TodoTransition{config: ttc.config}
+		_spec = sqlgraph.NewCreateSpec(todotransition.Table, sqlgraph.NewFieldSpec(todotransition.FieldID, field.TypeInt))
+	)
+	if value, ok := ttc.mutation.FromStatus(); ok {
+		_spec.SetField(todotransition.FieldFromStatus, field.TypeString, value)
+		_node.FromStatus = &value
+	}
+	if value, ok := ttc.mutation.ToStatus(); ok {
+		_spec.SetField(todotransition.FieldToStatus, field.TypeString, value)
+		_node.ToStatus = value
+	}
+	if value, ok := ttc.mutation.CreatedAt(); ok {
+		_spec.SetField(todotransition.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ttc.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todotransition.TodoTable,
+			Columns: []string{todotransition.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TodoID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TodoTransitionCreateBulk is the builder for creating many TodoTransition entities in bulk.
+type TodoTransitionCreateBulk struct {
+	config
+	err      error
+	builders []*TodoTransitionCreate
+}
+
+// Save creates the TodoTransition entities in the database.
+func (ttcb *TodoTransitionCreateBulk) Save(ctx context.Context) ([]*TodoTransition, error) {
+	if ttcb.err != nil {
+		return nil, ttcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ttcb.builders))
+	nodes := make([]*TodoTransition, len(ttcb.builders))
+	mutators := make([]Mutator, len(ttcb.builders))
+	for i := range ttcb.builders {
+		func(i int, root context.Context) {
+			builder := ttcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TodoTransitionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ttcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ttcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ttcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttcb *TodoTransitionCreateBulk) SaveX(ctx context.Context) []*TodoTransition {
+	v, err := ttcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ttcb *TodoTransitionCreateBulk) Exec(ctx context.Context) error {
+	_, err := ttcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttcb *TodoTransitionCreateBulk) ExecX(ctx context.Context) {
+	if err := ttcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
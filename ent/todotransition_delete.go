@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"todo-api-golang/ent/predicate"
+	"todo-api-golang/ent/todotransition"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoTransitionDelete is the builder for deleting a TodoTransition entity.
+type TodoTransitionDelete struct {
+	config
+	hooks    []Hook
+	mutation *TodoTransitionMutation
+}
+
+// Where appends a list predicates to the TodoTransitionDelete builder.
+func (ttd *TodoTransitionDelete) Where(ps ...predicate.TodoTransition) *TodoTransitionDelete {
+	ttd.mutation.Where(ps...)
+	return ttd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ttd *TodoTransitionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ttd.sqlExec, ttd.mutation, ttd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttd *TodoTransitionDelete) ExecX(ctx context.Context) int {
+	n, err := ttd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ttd *TodoTransitionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(todotransition.Table, sqlgraph.NewFieldSpec(todotransition.FieldID, field.TypeInt))
+	if ps := ttd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ttd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ttd.mutation.done = true
+	return affected, err
+}
+
+// TodoTransitionDeleteOne is the builder for deleting a single TodoTransition entity.
+type TodoTransitionDeleteOne struct {
+	ttd *TodoTransitionDelete
+}
+
+// Where appends a list predicates to the TodoTransitionDelete builder.
+func (ttdo *TodoTransitionDeleteOne) Where(ps ...predicate.TodoTransition) *TodoTransitionDeleteOne {
+	ttdo.ttd.mutation.Where(ps...)
+	return ttdo
+}
+
+// Exec executes the deletion query.
+func (ttdo *TodoTransitionDeleteOne) Exec(ctx context.Context) error {
+	n, err := ttdo.ttd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{todotransition.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttdo *TodoTransitionDeleteOne) ExecX(ctx context.Context) {
+	if err := ttdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
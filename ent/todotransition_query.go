@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"todo-api-golang/ent/predicate"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/todotransition"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoTransitionQuery is the builder for querying TodoTransition entities.
+type TodoTransitionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []todotransition.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TodoTransition
+	withTodo   *TodoQuery
+	loadTotal  []func(context.Context, []*TodoTransition) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TodoTransitionQuery builder.
+func (ttq *TodoTransitionQuery) Where(ps ...predicate.TodoTransition) *TodoTransitionQuery {
+	ttq.predicates = append(ttq.predicates, ps...)
+	return ttq
+}
+
+// Limit the number of records to be returned by this query.
+func (ttq *TodoTransitionQuery) Limit(limit int) *TodoTransitionQuery {
+	ttq.ctx.Limit = &limit
+	return ttq
+}
+
+// Offset to start from.
+func (ttq *TodoTransitionQuery) Offset(offset int) *TodoTransitionQuery {
+	ttq.ctx.Offset = &offset
+	return ttq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ttq *TodoTransitionQuery) Unique(unique bool) *TodoTransitionQuery {
+	ttq.ctx.Unique = &unique
+	return ttq
+}
+
+// Order specifies how the records should be ordered.
+func (ttq *TodoTransitionQuery) Order(o ...todotransition.OrderOption) *TodoTransitionQuery {
+	ttq.order = append(ttq.order, o...)
+	return ttq
+}
+
+// QueryTodo chains the current query on the "todo" edge.
+func (ttq *TodoTransitionQuery) QueryTodo() *TodoQuery {
+	query := (&TodoClient{config: ttq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ttq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ttq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todotransition.Table, todotransition.FieldID, selector),
+			sqlgraph.To(todo.Table, todo.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todotransition.TodoTable, todotransition.TodoColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ttq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TodoTransition entity from the query.
+// Returns a *NotFoundError when no TodoTransition was found.
+func (ttq *TodoTransitionQuery) First(ctx context.Context) (*TodoTransition, error) {
+	nodes, err := ttq.Limit(1).All(setContextOp(ctx, ttq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{todotransition.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ttq *TodoTransitionQuery) FirstX(ctx context.Context) *TodoTransition {
+	node, err := ttq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TodoTransition ID from the query.
+// Returns a *NotFoundError when no TodoTransition ID was found.
+func (ttq *TodoTransitionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ttq.Limit(1).IDs(setContextOp(ctx, ttq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{todotransition.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ttq *TodoTransitionQuery) FirstIDX(ctx context.Context) int {
+	id, err := ttq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TodoTransition entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TodoTransition entity is found.
+// Returns a *NotFoundError when no TodoTransition entities are found.
+func (ttq *TodoTransitionQuery) Only(ctx context.Context) (*TodoTransition, error) {
+	nodes, err := ttq.Limit(2).All(setContextOp(ctx, ttq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{todotransition.Label}
+	default:
+		return nil, &NotSingularError{todotransition.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ttq *TodoTransitionQuery) OnlyX(ctx context.Context) *TodoTransition {
+	node, err := ttq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TodoTransition ID in the query.
+// Returns a *NotSingularError when more than one TodoTransition ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ttq *TodoTransitionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ttq.Limit(2).IDs(setContextOp(ctx, ttq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{todotransition.Label}
+	default:
+		err = &NotSingularError{todotransition.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ttq *TodoTransitionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ttq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TodoTransitions.
+func (ttq *TodoTransitionQuery) All(ctx context.Context) ([]*TodoTransition, error) {
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryAll)
+	if err := ttq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TodoTransition, *TodoTransitionQuery]()
+	return withInterceptors[[]*TodoTransition](ctx, ttq, qr, ttq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ttq *TodoTransitionQuery) AllX(ctx context.Context) []*TodoTransition {
+	nodes, err := ttq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TodoTransition IDs.
+func (ttq *TodoTransitionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ttq.ctx.Unique == nil && ttq.path != nil {
+		ttq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryIDs)
+	if err = ttq.Select(todotransition.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ttq *TodoTransitionQuery) IDsX(ctx context.Context) []int {
+	ids, err := ttq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ttq *TodoTransitionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryCount)
+	if err := ttq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ttq, querierCount[*TodoTransitionQuery](), ttq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ttq *TodoTransitionQuery) CountX(ctx context.Context) int {
+	count, err := ttq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ttq *TodoTransitionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryExist)
+	switch _, err := ttq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ttq *TodoTransitionQuery) ExistX(ctx context.Context) bool {
+	exist, err := ttq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TodoTransitionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ttq *TodoTransitionQuery) Clone() *TodoTransitionQuery {
+	if ttq == nil {
+		return nil
+	}
+	return &TodoTransitionQuery{
+		config:     ttq.config,
+		ctx:        ttq.ctx.Clone(),
+		order:      append([]todotransition.OrderOption{}, ttq.order...),
+		inters:     append([]Interceptor{}, ttq.inters...),
+		predicates: append([]predicate.TodoTransition{}, ttq.predicates...),
+		withTodo:   ttq.withTodo.Clone(),
+		// clone intermediate query.
+		sql:  ttq.sql.Clone(),
+		path: ttq.path,
+	}
+}
+
+// WithTodo tells the query-builder to eager-load the nodes that are connected to
+// the "todo" edge. The optional arguments are used to configure the query builder of the edge.
+func (ttq *TodoTransitionQuery) WithTodo(opts ...func(*TodoQuery)) *TodoTransitionQuery {
+	query := (&TodoClient{config: ttq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ttq.withTodo = query
+	return ttq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TodoID int `json:"todo_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TodoTransition.Query().
+//		GroupBy(todotransition.FieldTodoID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ttq *TodoTransitionQuery) GroupBy(field string, fields ...string) *TodoTransitionGroupBy {
+	ttq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TodoTransitionGroupBy{build: ttq}
+	grbuild.flds = &ttq.ctx.Fields
+	grbuild.label = todotransition.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TodoID int `json:"todo_id,omitempty"`
+//	}
+//
+//	client.TodoTransition.Query().
+//		Select(todotransition.FieldTodoID).
+//		Scan(ctx, &v)
+func (ttq *TodoTransitionQuery) Select(fields ...string) *TodoTransitionSelect {
+	ttq.ctx.Fields = append(ttq.ctx.Fields, fields...)
+	sbuild := &TodoTransitionSelect{TodoTransitionQuery: ttq}
+	sbuild.label = todotransition.Label
+	sbuild.flds, sbuild.scan = &ttq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TodoTransitionSelect configured with the given aggregations.
+func (ttq *TodoTransitionQuery) Aggregate(fns ...AggregateFunc) *TodoTransitionSelect {
+	return ttq.Select().Aggregate(fns...)
+}
+
+func (ttq *TodoTransitionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ttq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ttq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ttq.ctx.Fields {
+		if !todotransition.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ttq.path != nil {
+		prev, err := ttq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ttq.sql = prev
+	}
+	return nil
+}
+
+func (ttq *TodoTransitionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TodoTransition, error) {
+	var (
+		nodes       = []*TodoTransition{}
+		_spec       = ttq.querySpec()
+		loadedTypes = [1]bool{
+			ttq.withTodo != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TodoTransition).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TodoTransition{config: ttq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ttq.modifiers) > 0 {
+		_spec.Modifiers = ttq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ttq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ttq.withTodo; query != nil {
+		if err := ttq.loadTodo(ctx, query, nodes, nil,
+			func(n *TodoTransition, e *Todo) { n.Edges.Todo = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range ttq.loadTotal {
+		if err := ttq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ttq *TodoTransitionQuery) loadTodo(ctx context.Context, query *TodoQuery, nodes []*TodoTransition, init func(*TodoTransition), assign func(*TodoTransition, *Todo)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TodoTransition)
+	for i := range nodes {
+		fk := nodes[i].TodoID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(todo.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "todo_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ttq *TodoTransitionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ttq.querySpec()
+	if len(ttq.modifiers) > 0 {
+		_spec.Modifiers = ttq.modifiers
+	}
+	_spec.Node.Columns = ttq.ctx.Fields
+	if len(ttq.ctx.Fields) > 0 {
+		_spec.Unique = ttq.ctx.Unique != nil && *ttq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ttq.driver, _spec)
+}
+
+func (ttq *TodoTransitionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(todotransition.Table, todotransition.Columns, sqlgraph.NewFieldSpec(todotransition.FieldID, field.TypeInt))
+	_spec.From = ttq.sql
+	if unique := ttq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ttq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ttq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todotransition.FieldID)
+		for i := range fields {
+			if fields[i] != todotransition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ttq.withTodo != nil {
+			_spec.Node.AddColumnOnce(todotransition.FieldTodoID)
+		}
+	}
+	if ps := ttq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ttq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ttq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ttq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ttq *TodoTransitionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ttq.driver.Dialect())
+	t1 := builder.Table(todotransition.Table)
+	columns := ttq.ctx.Fields
+	if len(columns) == 0 {
+		columns = todotransition.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ttq.sql != nil {
+		selector = ttq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ttq.ctx.Unique != nil && *ttq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ttq.modifiers {
+		m(selector)
+	}
+	for _, p := range ttq.predicates {
+		p(selector)
+	}
+	for _, p := range ttq.order {
+		p(selector)
+	}
+	if offset := ttq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ttq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ttq *TodoTransitionQuery) ForUpdate(opts ...sql.LockOption) *TodoTransitionQuery {
+	if ttq.driver.Dialect() == dialect.Postgres {
+		ttq.Unique(false)
+	}
+	ttq.modifiers = append(ttq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ttq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ttq *TodoTransitionQuery) ForShare(opts ...sql.LockOption) *TodoTransitionQuery {
+	if ttq.driver.Dialect() == dialect.Postgres {
+		ttq.Unique(false)
+	}
+	ttq.modifiers = append(ttq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ttq
+}
+
+// TodoTransitionGroupBy is the group-by builder for TodoTransition entities.
+type TodoTransitionGroupBy struct {
+	selector
+	build *TodoTransitionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ttgb *TodoTransitionGroupBy) Aggregate(fns ...AggregateFunc) *TodoTransitionGroupBy {
+	ttgb.fns = append(ttgb.fns, fns...)
+	return ttgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ttgb *TodoTransitionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ttgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ttgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TodoTransitionQuery, *TodoTransitionGroupBy](ctx, ttgb.build, ttgb, ttgb.build.inters, v)
+}
+
+func (ttgb *TodoTransitionGroupBy) sqlScan(ctx context.Context, root *TodoTransitionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ttgb.fns))
+	for _, fn := range ttgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ttgb.flds)+len(ttgb.fns))
+		for _, f := range *ttgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ttgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ttgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TodoTransitionSelect is the builder for selecting fields of TodoTransition entities.
+type TodoTransitionSelect struct {
+	*TodoTransitionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tts *TodoTransitionSelect) Aggregate(fns ...AggregateFunc) *TodoTransitionSelect {
+	tts.fns = append(tts.fns, fns...)
+	return tts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tts *TodoTransitionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tts.ctx, ent.OpQuerySelect)
+	if err := tts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TodoTransitionQuery, *TodoTransitionSelect](ctx, tts.TodoTransitionQuery, tts, tts.inters, v)
+}
+
+func (tts *TodoTransitionSelect) sqlScan(ctx context.Context, root *TodoTransitionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tts.fns))
+	for _, fn := range tts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"todo-api-golang/ent/predicate"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/todotransition"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TodoTransitionUpdate is the builder for updating TodoTransition entities.
+type TodoTransitionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TodoTransitionMutation
+}
+
+// Where appends a list predicates to the TodoTransitionUpdate builder.
+func (ttu *TodoTransitionUpdate) Where(ps ...predicate.TodoTransition) *TodoTransitionUpdate {
+	ttu.mutation.Where(ps...)
+	return ttu
+}
+
+// SetTodoID sets the "todo_id" field.
+func (ttu *TodoTransitionUpdate) SetTodoID(i int) *TodoTransitionUpdate {
+	ttu.mutation.SetTodoID(i)
+	return ttu
+}
+
+// SetNillableTodoID sets the "todo_id" field if the given value is not nil.
+func (ttu *TodoTransitionUpdate) SetNillableTodoID(i *int) *TodoTransitionUpdate {
+	if i != nil {
+		ttu.SetTodoID(*i)
+	}
+	return ttu
+}
+
+// SetFromStatus sets the "from_status" field.
+func (ttu *TodoTransitionUpdate) SetFromStatus(s string) *TodoTransitionUpdate {
+	ttu.mutation.SetFromStatus(s)
+	return ttu
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (ttu *TodoTransitionUpdate) SetNillableFromStatus(s *string) *TodoTransitionUpdate {
+	if s != nil {
+		ttu.SetFromStatus(*s)
+	}
+	return ttu
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (ttu *TodoTransitionUpdate) ClearFromStatus() *TodoTransitionUpdate {
+	ttu.mutation.ClearFromStatus()
+	return ttu
+}
+
+// SetToStatus sets the "to_status" field.
+func (ttu *TodoTransitionUpdate) SetToStatus(s string) *TodoTransitionUpdate {
+	ttu.mutation.SetToStatus(s)
+	return ttu
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (ttu *TodoTransitionUpdate) SetNillableToStatus(s *string) *TodoTransitionUpdate {
+	if s != nil {
+		ttu.SetToStatus(*s)
+	}
+	return ttu
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (ttu *TodoTransitionUpdate) SetTodo(t *Todo) *TodoTransitionUpdate {
+	return ttu.SetTodoID(t.ID)
+}
+
+// Mutation returns the TodoTransitionMutation object of the builder.
+func (ttu *TodoTransitionUpdate) Mutation() *TodoTransitionMutation {
+	return ttu.mutation
+}
+
+// ClearTodo clears the "todo" edge to the Todo entity.
+func (ttu *TodoTransitionUpdate) ClearTodo() *TodoTransitionUpdate {
+	ttu.mutation.ClearTodo()
+	return ttu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ttu *TodoTransitionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ttu.sqlSave, ttu.mutation, ttu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttu *TodoTransitionUpdate) SaveX(ctx context.Context) int {
+	affected, err := ttu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ttu *TodoTransitionUpdate) Exec(ctx context.Context) error {
+	_, err := ttu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttu *TodoTransitionUpdate) ExecX(ctx context.Context) {
+	if err := ttu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ttu *TodoTransitionUpdate) check() error {
+	if v, ok := ttu.mutation.ToStatus(); ok {
+		if err := todotransition.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "TodoTransition.to_status": %w`, err)}
+		}
+	}
+	if ttu.mutation.TodoCleared() && len(ttu.mutation.TodoIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoTransition.todo"`)
+	}
+	return nil
+}
+
+func (ttu *TodoTransitionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ttu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todotransition.Table, todotransition.Columns, sqlgraph.NewFieldSpec(todotransition.FieldID, field.TypeInt))
+	if ps := ttu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ttu.mutation.FromStatus(); ok {
+		_spec.SetField(todotransition.FieldFromStatus, field.TypeString, value)
+	}
+	if ttu.mutation.FromStatusCleared() {
+		_spec.ClearField(todotransition.FieldFromStatus, field.TypeString)
+	}
+	if value, ok := ttu.mutation.ToStatus(); ok {
+		_spec.SetField(todotransition.FieldToStatus, field.TypeString, value)
+	}
+	if ttu.mutation.TodoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todotransition.TodoTable,
+			Columns: []string{todotransition.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ttu.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todotransition.TodoTable,
+			Columns: []string{todotransition.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ttu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todotransition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ttu.mutation.done = true
+	return n, nil
+}
+
+// TodoTransitionUpdateOne is the builder for updating a single TodoTransition entity.
+type TodoTransitionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoTransitionMutation
+}
+
+// SetTodoID sets the "todo_id" field.
+func (ttuo *TodoTransitionUpdateOne) SetTodoID(i int) *TodoTransitionUpdateOne {
+	ttuo.mutation.SetTodoID(i)
+	return ttuo
+}
+
+// SetNillableTodoID sets the "todo_id" field if the given value is not nil.
+func (ttuo *TodoTransitionUpdateOne) SetNillableTodoID(i *int) *TodoTransitionUpdateOne {
+	if i != nil {
+		ttuo.SetTodoID(*i)
+	}
+	return ttuo
+}
+
+// SetFromStatus sets the "from_status" field.
+func (ttuo *TodoTransitionUpdateOne) SetFromStatus(s string) *TodoTransitionUpdateOne {
+	ttuo.mutation.SetFromStatus(s)
+	return ttuo
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (ttuo *TodoTransitionUpdateOne) SetNillableFromStatus(s *string) *TodoTransitionUpdateOne {
+	if s != nil {
+		ttuo.SetFromStatus(*s)
+	}
+	return ttuo
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (ttuo *TodoTransitionUpdateOne) ClearFromStatus() *TodoTransitionUpdateOne {
+	ttuo.mutation.ClearFromStatus()
+	return ttuo
+}
+
+// SetToStatus sets the "to_status" field.
+func (ttuo *TodoTransitionUpdateOne) SetToStatus(s string) *TodoTransitionUpdateOne {
+	ttuo.mutation.SetToStatus(s)
+	return ttuo
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (ttuo *TodoTransitionUpdateOne) SetNillableToStatus(s *string) *TodoTransitionUpdateOne {
+	if s != nil {
+		ttuo.SetToStatus(*s)
+	}
+	return ttuo
+}
+
+// SetTodo sets the "todo" edge to the Todo entity.
+func (ttuo *TodoTransitionUpdateOne) SetTodo(t *Todo) *TodoTransitionUpdateOne {
+	return ttuo.SetTodoID(t.ID)
+}
+
+// Mutation returns the TodoTransitionMutation object of the builder.
+func (ttuo *TodoTransitionUpdateOne) Mutation() *TodoTransitionMutation {
+	return ttuo.mutation
+}
+
+// ClearTodo clears the "todo" edge to the Todo entity.
+func (ttuo *TodoTransitionUpdateOne) ClearTodo() *TodoTransitionUpdateOne {
+	ttuo.mutation.ClearTodo()
+	return ttuo
+}
+
+// Where appends a list predicates to the TodoTransitionUpdate builder.
+func (ttuo *TodoTransitionUpdateOne) Where(ps ...predicate.TodoTransition) *TodoTransitionUpdateOne {
+	ttuo.mutation.Where(ps...)
+	return ttuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ttuo *TodoTransitionUpdateOne) Select(field string, fields ...string) *TodoTransitionUpdateOne {
+	ttuo.fields = append([]string{field}, fields...)
+	return ttuo
+}
+
+// Save executes the query and returns the updated TodoTransition entity.
+func (ttuo *TodoTransitionUpdateOne) Save(ctx context.Context) (*TodoTransition, error) {
+	return withHooks(ctx, ttuo.sqlSave, ttuo.mutation, ttuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttuo *TodoTransitionUpdateOne) SaveX(ctx context.Context) *TodoTransition {
+	node, err := ttuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ttuo *TodoTransitionUpdateOne) Exec(ctx context.Context) error {
+	_, err := ttuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttuo *TodoTransitionUpdateOne) ExecX(ctx context.Context) {
+	if err := ttuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ttuo *TodoTransitionUpdateOne) check() error {
+	if v, ok := ttuo.mutation.ToStatus(); ok {
+		if err := todotransition.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "TodoTransition.to_status": %w`, err)}
+		}
+	}
+	if ttuo.mutation.TodoCleared() && len(ttuo.mutation.TodoIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TodoTransition.todo"`)
+	}
+	return nil
+}
+
+func (ttuo *TodoTransitionUpdateOne) sqlSave(ctx context.Context) (_node *TodoTransition, err error) {
+	if err := ttuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(todotransition.Table, todotransition.Columns, sqlgraph.NewFieldSpec(todotransition.FieldID, field.TypeInt))
+	id, ok := ttuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TodoTransition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ttuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todotransition.FieldID)
+		for _, f := range fields {
+			if !todotransition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != todotransition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ttuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ttuo.mutation.FromStatus(); ok {
+		_spec.SetField(todotransition.FieldFromStatus, field.TypeString, value)
+	}
+	if ttuo.mutation.FromStatusCleared() {
+		_spec.ClearField(todotransition.FieldFromStatus, field.TypeString)
+	}
+	if value, ok := ttuo.mutation.ToStatus(); ok {
+		_spec.SetField(todotransition.FieldToStatus, field.TypeString, value)
+	}
+	if ttuo.mutation.TodoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todotransition.TodoTable,
+			Columns: []string{todotransition.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ttuo.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todotransition.TodoTable,
+			Columns: []string{todotransition.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todo.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TodoTransition{config: ttuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ttuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todotransition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ttuo.mutation.done = true
+	return _node, nil
+}
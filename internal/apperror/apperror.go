@@ -0,0 +1,123 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Code is a stable, machine-readable error code returned to API clients.
+type Code string
+
+// 클라이언트가 의존하는 값이므로 기존 코드의 문자열은 변경하지 않습니다.
+const (
+	CodeBadRequest           Code = "BAD_REQUEST"
+	CodeInvalidParameter     Code = "INVALID_PARAMETER"
+	CodeInvalidQuery         Code = "INVALID_QUERY"
+	CodeMalformedJSON        Code = "MALFORMED_JSON"
+	CodeUnknownField         Code = "UNKNOWN_FIELD"
+	CodeInvalidType          Code = "INVALID_TYPE"
+	CodeEmptyBody            Code = "EMPTY_BODY"
+	CodeBodyTooLarge         Code = "BODY_TOO_LARGE"
+	CodeUnsupportedMediaType Code = "UNSUPPORTED_MEDIA_TYPE"
+	CodeValidationFailed     Code = "VALIDATION_FAILED"
+	CodeSubtaskDepthExceeded Code = "SUBTASK_DEPTH_EXCEEDED"
+	CodeSubtaskCycle         Code = "SUBTASK_CYCLE"
+	CodeInvalidPatch         Code = "INVALID_PATCH"
+	CodeBulkLimitExceeded    Code = "BULK_LIMIT_EXCEEDED"
+	CodeIdempotencyKeyReused Code = "IDEMPOTENCY_KEY_REUSED"
+	CodeImportLimitExceeded  Code = "IMPORT_LIMIT_EXCEEDED"
+
+	CodeUnauthorized        Code = "UNAUTHORIZED"
+	CodeTokenExpired        Code = "TOKEN_EXPIRED"
+	CodeInvalidToken        Code = "INVALID_TOKEN"
+	CodeInvalidCredentials  Code = "INVALID_CREDENTIALS"
+	CodeInvalidRefreshToken Code = "INVALID_REFRESH_TOKEN"
+
+	CodeNotFound         Code = "NOT_FOUND"
+	CodeRouteNotFound    Code = "ROUTE_NOT_FOUND"
+	CodeMethodNotAllowed Code = "METHOD_NOT_ALLOWED"
+	CodeTodoNotFound     Code = "TODO_NOT_FOUND"
+	CodeUserNotFound     Code = "USER_NOT_FOUND"
+	CodeProjectNotFound  Code = "PROJECT_NOT_FOUND"
+	CodeTagNotFound      Code = "TAG_NOT_FOUND"
+	CodeRevisionNotFound Code = "REVISION_NOT_FOUND"
+	CodeWebhookNotFound  Code = "WEBHOOK_NOT_FOUND"
+	CodeDeliveryNotFound Code = "DELIVERY_NOT_FOUND"
+
+	CodeConflict          Code = "CONFLICT"
+	CodeEmailTaken        Code = "EMAIL_TAKEN"
+	CodeProjectArchived   Code = "PROJECT_ARCHIVED"
+	CodeTagNameTaken      Code = "TAG_NAME_TAKEN"
+	CodeInvalidTransition Code = "INVALID_TRANSITION"
+	CodeRequestInProgress Code = "REQUEST_IN_PROGRESS"
+
+	CodePreconditionFailed Code = "PRECONDITION_FAILED"
+
+	CodeInternal Code = "INTERNAL_ERROR"
+)
+
+// FieldError describes why a single field of the request was rejected.
+type FieldError struct {
+	Field string      `json:"field"`
+	Rule  string      `json:"rule"`
+	Param string      `json:"param,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// Error is the typed error returned by services and request binding.
+// The HTTP status is derived from Code by the response layer.
+type Error struct {
+	Code    Code
+	Message string
+	Fields  []FieldError
+	Err     error
+}
+
+// New creates an Error with the given code and client-facing message.
+func New(code Code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+// Newf creates an Error with a formatted client-facing message.
+func Newf(code Code, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// Wrap creates an Error that keeps err as its cause.
+func Wrap(err error, code Code, message string) *Error {
+	return &Error{Code: code, Message: message, Err: err}
+}
+
+// WithFields returns a copy of e carrying the given field errors.
+func (e *Error) WithFields(fields ...FieldError) *Error {
+	copied := *e
+	copied.Fields = fields
+	return &copied
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Err)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is an *Error with the same code,
+// so that errors.Is can be used against the sentinel errors of the services.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && e.Code == t.Code
+}
+
+// CodeOf returns the code of err, or CodeInternal when err is not an *Error.
+func CodeOf(err error) Code {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return CodeInternal
+}
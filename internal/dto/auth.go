@@ -0,0 +1,30 @@
+package dto
+
+import "time"
+
+// LoginForm is the structure for logging in with email and password.
+type LoginForm struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required" sensitive:"true"`
+}
+
+// RefreshTokenForm is the structure for refreshing or revoking a session.
+type RefreshTokenForm struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+// TokenDTO is returned after a successful login or token refresh.
+type TokenDTO struct {
+	TokenType             string    `json:"token_type"`
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+	User                  UserDTO   `json:"user"`
+}
+
+// ClientInfo describes the client that requested a session.
+type ClientInfo struct {
+	UserAgent string
+	ClientIP  string
+}
@@ -0,0 +1,67 @@
+package dto
+
+// Bulk actions for BulkTodoForm.Action.
+const (
+	BulkSetStatus  = "set_status"
+	BulkDelete     = "delete"
+	BulkRestore    = "restore"
+	BulkSetProject = "set_project"
+	BulkAddTag     = "add_tag"
+	BulkRemoveTag  = "remove_tag"
+)
+
+// BulkTodoForm applies one action to many todos in a single transaction.
+// The todos are given either by IDs or by Filter; restore selects from the trash, every other
+// action from the live todos. Status is used by set_status, ProjectID by set_project (null moves
+// the todos out of their project) and Tag by add_tag and remove_tag.
+// With AllOrNothing nothing is changed unless the action succeeds for every todo.
+type BulkTodoForm struct {
+	IDs          []int           `json:"ids" validate:"required_without=Filter,excluded_with=Filter,omitempty,min=1,dive,min=1"`
+	Filter       *BulkTodoFilter `json:"filter" validate:"required_without=IDs"`
+	Action       string          `json:"action" validate:"required,oneof=set_status delete restore set_project add_tag remove_tag"`
+	Status       string          `json:"status" validate:"required_if=Action set_status,omitempty,todo_status"`
+	ProjectID    *int            `json:"project_id"`
+	Tag          string          `json:"tag" validate:"max=50"`
+	AllOrNothing bool            `json:"all_or_nothing"`
+}
+
+// BulkTodoFilter selects todos like the filters of the todo list.
+type BulkTodoFilter struct {
+	Statuses  []string `json:"status"`
+	Search    string   `json:"q"`
+	Tags      []string `json:"tags"`
+	TagMode   string   `json:"tag_mode" validate:"omitempty,oneof=any all"`
+	ProjectID *int     `json:"project_id" validate:"excluded_with=NoProject"`
+	NoProject bool     `json:"no_project"`
+	Due       string   `json:"due" validate:"omitempty,oneof=overdue today week"`
+}
+
+// ListQuery converts the filter into the query used by the todo list.
+func (f BulkTodoFilter) ListQuery() TodoListQuery {
+	return TodoListQuery{
+		Statuses:  f.Statuses,
+		Search:    f.Search,
+		Tags:      f.Tags,
+		TagMode:   f.TagMode,
+		ProjectID: f.ProjectID,
+		NoProject: f.NoProject,
+		Due:       f.Due,
+	}
+}
+
+// BulkItemResult is the outcome of a bulk action for a single todo.
+type BulkItemResult struct {
+	ID        int    `json:"id"`
+	OK        bool   `json:"ok"`
+	ErrorCode string `json:"error_code,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+// BulkResultDTO reports the outcome of a bulk action.
+// Committed is false when an all-or-nothing request was rolled back.
+type BulkResultDTO struct {
+	Committed bool             `json:"committed"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Results   []BulkItemResult `json:"results"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+// CalendarTokenDTO is the token of the calendar feed of a user.
+// The token is only returned when it is issued; FeedURL is the path of the feed including the token.
+type CalendarTokenDTO struct {
+	Token   string `json:"token"`
+	FeedURL string `json:"feed_url"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"time"
+	"todo-api-golang/ent/schema"
+	"todo-api-golang/internal/events"
+)
+
+// TodoEventDTO is a change of a todo sent over the change feed.
+// IDs increase with every change; the ID of the last event received can be sent back
+// as Last-Event-ID to resume the feed. Changes are the same as in the history of the todo.
+type TodoEventDTO struct {
+	ID        int                           `json:"id"`
+	Type      string                        `json:"type"`
+	TodoID    int                           `json:"todo_id"`
+	Changes   map[string]schema.FieldChange `json:"changes"`
+	CreatedAt time.Time                     `json:"created_at"`
+}
+
+// ConvertTodoEventToDTO converts an event to TodoEventDTO.
+func ConvertTodoEventToDTO(event events.Event) TodoEventDTO {
+	return TodoEventDTO{
+		ID:        event.ID,
+		Type:      event.Type,
+		TodoID:    event.TodoID,
+		Changes:   ConvertTodoRevisionToDTO(event.Revision).Changes,
+		CreatedAt: event.Revision.CreatedAt,
+	}
+}
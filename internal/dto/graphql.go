@@ -0,0 +1,10 @@
+package dto
+
+// GraphQLRequest is the body of a GraphQL operation sent over HTTP. It documents the request of the
+// GraphQL endpoint; the body is decoded by the GraphQL server.
+type GraphQLRequest struct {
+	Query         string                 `json:"query" validate:"required"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+	Extensions    map[string]interface{} `json:"extensions"`
+}
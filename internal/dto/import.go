@@ -0,0 +1,51 @@
+package dto
+
+import "todo-api-golang/internal/apperror"
+
+// Formats of todo exports and imports.
+const (
+	FormatCSV      = "csv"
+	FormatNDJSON   = "ndjson"
+	FormatMarkdown = "markdown"
+	FormatICS      = "ics"
+	FormatTodoTxt  = "todotxt"
+)
+
+// Actions of an imported row.
+const (
+	ImportCreated = "created"
+	ImportUpdated = "updated"
+)
+
+// ImportRow is a todo read from an import file. Line is the line of the file the row starts on.
+// UID is set by formats that identify todos, i.e. iCalendar; a row with the UID of an existing
+// todo updates that todo instead of creating another one.
+// Err is set when the row could not be read or failed validation; such a row is not imported.
+type ImportRow struct {
+	Line int
+	UID  string
+	Form TodoForm
+	Err  error
+}
+
+// ImportRowResult is the outcome of an import for a single row.
+// ID is the ID of the created or updated todo; it is only set when the import was committed.
+type ImportRowResult struct {
+	Line      int                   `json:"line"`
+	OK        bool                  `json:"ok"`
+	Action    string                `json:"action,omitempty"`
+	ID        int                   `json:"id,omitempty"`
+	ErrorCode string                `json:"error_code,omitempty"`
+	Message   string                `json:"message,omitempty"`
+	Errors    []apperror.FieldError `json:"errors,omitempty"`
+}
+
+// ImportResultDTO reports the outcome of an import.
+// Committed is false for a dry run and when any row failed, in which case no todo was changed.
+type ImportResultDTO struct {
+	DryRun    bool              `json:"dry_run"`
+	Committed bool              `json:"committed"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+	Results   []ImportRowResult `json:"results"`
+}
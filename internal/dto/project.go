@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"time"
+	"todo-api-golang/ent"
+)
+
+// ProjectDTO is a Data Transfer Object for Project entity.
+type ProjectDTO struct {
+	ID        int        `json:"id"`
+	Name      string     `json:"name"`
+	Color     string     `json:"color,omitempty"`
+	Archived  bool       `json:"archived"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// ConvertProjectToDTO converts a Project entity to ProjectDTO.
+func ConvertProjectToDTO(project *ent.Project) ProjectDTO {
+	return ProjectDTO{
+		ID:        project.ID,
+		Name:      project.Name,
+		Color:     project.Color,
+		Archived:  project.Archived,
+		CreatedAt: project.CreatedAt,
+		UpdatedAt: project.UpdatedAt,
+		DeletedAt: project.DeletedAt,
+	}
+}
+
+// ProjectForm is the structure for creating or updating a Project.
+type ProjectForm struct {
+	Name  string `json:"name" validate:"required,max=100"`
+	Color string `json:"color" validate:"omitempty,hexcolor"`
+}
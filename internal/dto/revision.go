@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/schema"
+)
+
+// TodoRevisionDTO is a recorded change of a todo.
+// Action is one of create, update, status, delete and restore.
+// UserID is empty for changes made by background workers.
+type TodoRevisionDTO struct {
+	ID        int                           `json:"id"`
+	Action    string                        `json:"action"`
+	UserID    *int                          `json:"user_id,omitempty"`
+	Changes   map[string]schema.FieldChange `json:"changes"`
+	CreatedAt time.Time                     `json:"created_at"`
+}
+
+// ConvertTodoRevisionToDTO converts a TodoRevision entity to TodoRevisionDTO.
+// Priorities are shown by name, as in TodoDTO.
+func ConvertTodoRevisionToDTO(revision *ent.TodoRevision) TodoRevisionDTO {
+	changes := make(map[string]schema.FieldChange, len(revision.Changes))
+	for name, change := range revision.Changes {
+		if name == "priority" {
+			change = schema.FieldChange{Old: priorityChange(change.Old), New: priorityChange(change.New)}
+		}
+		changes[name] = change
+	}
+	return TodoRevisionDTO{
+		ID:        revision.ID,
+		Action:    string(revision.Action),
+		UserID:    revision.UserID,
+		Changes:   changes,
+		CreatedAt: revision.CreatedAt,
+	}
+}
+
+// priorityChange converts a recorded priority value, decoded from JSON, to its name.
+func priorityChange(value interface{}) interface{} {
+	if v, ok := value.(float64); ok {
+		return PriorityName(int(v))
+	}
+	return value
+}
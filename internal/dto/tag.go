@@ -0,0 +1,30 @@
+package dto
+
+import "todo-api-golang/ent"
+
+// TagDTO is a Data Transfer Object for Tag entity.
+// TodoCount is the number of the user's todos, outside the trash, that carry the tag.
+type TagDTO struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	TodoCount int    `json:"todo_count"`
+}
+
+// ConvertTagToDTO converts a Tag entity to TagDTO with the given number of todos.
+func ConvertTagToDTO(tag *ent.Tag, todoCount int) TagDTO {
+	return TagDTO{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		TodoCount: todoCount,
+	}
+}
+
+// TagForm is the structure for creating or renaming a Tag.
+type TagForm struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
+
+// MergeTagForm is the structure for merging a Tag into another one.
+type MergeTagForm struct {
+	IntoID int `json:"into_id" validate:"required"`
+}
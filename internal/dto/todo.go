@@ -1,41 +1,241 @@
 package dto
 
 import (
+	"fmt"
 	"time"
 	"todo-api-golang/ent"
 )
 
 // TodoDTO is a Data Transfer Object for Todo entity.
 type TodoDTO struct {
-	ID          int        `json:"id"`
-	Title       string     `json:"title"`
-	Description string     `json:"description,omitempty"`
-	Status      string     `json:"status"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
-	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	ID           int           `json:"id"`
+	UID          string        `json:"uid"`
+	Title        string        `json:"title"`
+	Description  string        `json:"description,omitempty"`
+	Status       string        `json:"status"`
+	Priority     string        `json:"priority"`
+	Position     string        `json:"position"`
+	ProjectID    *int          `json:"project_id,omitempty"`
+	Tags         []string      `json:"tags,omitempty"`
+	ParentID     *int          `json:"parent_id,omitempty"`
+	AutoComplete bool          `json:"auto_complete"`
+	Progress     *TodoProgress `json:"progress,omitempty"`
+	DueAt        *time.Time    `json:"due_at,omitempty"`
+	RemindAt     *time.Time    `json:"remind_at,omitempty"`
+	StartedAt    *time.Time    `json:"started_at,omitempty"`
+	CompletedAt  *time.Time    `json:"completed_at,omitempty"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+	DeletedAt    *time.Time    `json:"deleted_at,omitempty"`
+	Version      int           `json:"version"`
+}
+
+// TodoProgress is the number of done subtasks out of all subtasks of a todo.
+type TodoProgress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
 }
 
 // ConvertTodoToDTO converts a Todo entity to TodoDTO.
+// Tags and Progress are only included when the tags and children edges have been loaded.
 func ConvertTodoToDTO(todo *ent.Todo) TodoDTO {
+	var tags []string
+	for _, t := range todo.Edges.Tags {
+		tags = append(tags, t.Name)
+	}
+	var progress *TodoProgress
+	if len(todo.Edges.Children) > 0 {
+		progress = &TodoProgress{Total: len(todo.Edges.Children)}
+		for _, child := range todo.Edges.Children {
+			// 완료 상태는 워크플로에 따라 달라지므로 completed_at으로 판단합니다.
+			if child.CompletedAt != nil {
+				progress.Done++
+			}
+		}
+	}
 	return TodoDTO{
-		ID:          todo.ID,
-		Title:       todo.Title,
-		Description: todo.Description,
-		Status:      string(todo.Status),
-		CreatedAt:   todo.CreatedAt,
-		UpdatedAt:   todo.UpdatedAt,
-		DeletedAt:   todo.DeletedAt,
+		ID:           todo.ID,
+		UID:          TodoUID(todo),
+		Title:        todo.Title,
+		Description:  todo.Description,
+		Status:       string(todo.Status),
+		Priority:     PriorityName(todo.Priority),
+		Position:     todo.Position,
+		ProjectID:    todo.ProjectID,
+		Tags:         tags,
+		ParentID:     todo.ParentID,
+		AutoComplete: todo.AutoComplete,
+		Progress:     progress,
+		DueAt:        todo.DueAt,
+		RemindAt:     todo.RemindAt,
+		StartedAt:    todo.StartedAt,
+		CompletedAt:  todo.CompletedAt,
+		CreatedAt:    todo.CreatedAt,
+		UpdatedAt:    todo.UpdatedAt,
+		DeletedAt:    todo.DeletedAt,
+		Version:      todo.Version,
+	}
+}
+
+// legacyUIDFormat is the UID of the todos created before todos were given a UID of their own.
+const legacyUIDFormat = "todo-%d@todo-api-golang"
+
+// TodoUID returns the iCalendar UID of a todo.
+func TodoUID(todo *ent.Todo) string {
+	if todo.UID != nil {
+		return *todo.UID
+	}
+	return fmt.Sprintf(legacyUIDFormat, todo.ID)
+}
+
+// LegacyUIDTodoID returns the ID of the todo a UID was derived from by TodoUID,
+// for todos that have no UID of their own.
+func LegacyUIDTodoID(uid string) (int, bool) {
+	var id int
+	if _, err := fmt.Sscanf(uid, legacyUIDFormat, &id); err != nil || fmt.Sprintf(legacyUIDFormat, id) != uid {
+		return 0, false
+	}
+	return id, true
+}
+
+// Priorities of a todo, from the least to the most important.
+const (
+	PriorityLow    = "LOW"
+	PriorityMedium = "MEDIUM"
+	PriorityHigh   = "HIGH"
+	PriorityUrgent = "URGENT"
+)
+
+// priorities maps the stored priority value to its name.
+var priorities = []string{PriorityLow, PriorityMedium, PriorityHigh, PriorityUrgent}
+
+// PriorityName returns the name of the stored priority value.
+func PriorityName(value int) string {
+	if value < 0 || value >= len(priorities) {
+		return PriorityMedium
+	}
+	return priorities[value]
+}
+
+// PriorityValue returns the stored value of the priority name. An empty name is MEDIUM.
+func PriorityValue(name string) (int, bool) {
+	if name == "" {
+		name = PriorityMedium
 	}
+	for i, p := range priorities {
+		if p == name {
+			return i, true
+		}
+	}
+	return 0, false
 }
 
 // TodoForm is the structure for creating or updating a Todo item.
+// DueAt, RemindAt, ProjectID and Tags are optional; omitting them on update clears them.
+// Tags are given by name and created on first use. Priority defaults to MEDIUM.
+// AutoComplete completes the todo once all of its subtasks are done.
+// Status must be a state of the configured workflow.
 type TodoForm struct {
-	Title       string `json:"title" validate:"required"`
-	Description string `json:"description"`
-	Status      string `json:"status" validate:"required,oneof=PENDING COMPLETED PROGRESS"`
+	Title        string     `json:"title" validate:"required"`
+	Description  string     `json:"description"`
+	Status       string     `json:"status" validate:"required,todo_status"`
+	Priority     string     `json:"priority" validate:"omitempty,oneof=LOW MEDIUM HIGH URGENT"`
+	ProjectID    *int       `json:"project_id"`
+	Tags         []string   `json:"tags" validate:"omitempty,max=20,dive,required,max=50"`
+	AutoComplete bool       `json:"auto_complete"`
+	DueAt        *time.Time `json:"due_at"`
+	RemindAt     *time.Time `json:"remind_at"`
 }
 
+// ConvertTodoToForm returns the form that would update a todo to its current state.
+// PATCH requests are applied to this form.
+func ConvertTodoToForm(todo TodoDTO) TodoForm {
+	return TodoForm{
+		Title:        todo.Title,
+		Description:  todo.Description,
+		Status:       todo.Status,
+		Priority:     todo.Priority,
+		ProjectID:    todo.ProjectID,
+		Tags:         todo.Tags,
+		AutoComplete: todo.AutoComplete,
+		DueAt:        todo.DueAt,
+		RemindAt:     todo.RemindAt,
+	}
+}
+
+// UpdateStatusForm moves a todo to another state of the workflow.
 type UpdateStatusForm struct {
-	Status string `json:"status" validate:"required,oneof=PENDING COMPLETED PROGRESS"`
+	Status string `json:"status" validate:"required,todo_status"`
+}
+
+// MoveTodoForm places a todo directly before or directly after another todo.
+// Exactly one of BeforeID and AfterID must be set.
+type MoveTodoForm struct {
+	BeforeID *int `json:"before_id" validate:"required_without=AfterID,excluded_with=AfterID"`
+	AfterID  *int `json:"after_id" validate:"required_without=BeforeID,excluded_with=BeforeID"`
+}
+
+// SetParentForm moves a todo under another todo. A null ParentID makes it a top-level todo.
+type SetParentForm struct {
+	ParentID *int `json:"parent_id"`
+}
+
+// SortField is a single ordering term parsed from the sort query parameter.
+// A leading "-" in the query parameter sets Desc.
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// Due filters for TodoListQuery.Due.
+const (
+	DueOverdue  = "overdue"
+	DueToday    = "today"
+	DueThisWeek = "week"
+)
+
+// Tag matching modes for TodoListQuery.TagMode.
+const (
+	TagMatchAny = "any"
+	TagMatchAll = "all"
+)
+
+// TodoListQuery holds the filters, ordering and pagination for listing todos.
+// Due is one of the Due* constants; its day boundaries are computed in Location.
+// NoProject selects the todos without a project and takes precedence over ProjectID.
+// Todos of archived projects are hidden unless IncludeArchived is set or ProjectID selects them.
+// Tags selects the todos that have any or all of the given tags, depending on TagMode.
+// RootsOnly selects the top-level todos, i.e. the todos that are not a subtask.
+type TodoListQuery struct {
+	Statuses        []string
+	Search          string
+	Tags            []string
+	TagMode         string
+	ProjectID       *int
+	NoProject       bool
+	IncludeArchived bool
+	RootsOnly       bool
+	Due             string
+	Location        *time.Location
+	CreatedAfter    *time.Time
+	CreatedBefore   *time.Time
+	UpdatedAfter    *time.Time
+	UpdatedBefore   *time.Time
+	Sort            []SortField
+	Cursor          string
+	Limit           int
+}
+
+// TodoPage is a single page of todos. NextCursor is empty on the last page.
+// Cursors holds the cursor after each todo of the page, for Relay-style connections.
+type TodoPage struct {
+	Todos      []TodoDTO
+	Cursors    []string
+	NextCursor string
+}
+
+// Reminder is a todo whose remind_at time has passed, together with its owner.
+type Reminder struct {
+	UserID int
+	Todo   TodoDTO
 }
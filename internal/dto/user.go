@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+	"todo-api-golang/ent"
+)
+
+// UserDTO is a Data Transfer Object for User entity.
+type UserDTO struct {
+	ID          int       `json:"id"`
+	Email       string    `json:"email"`
+	DisplayName string    `json:"display_name"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ConvertUserToDTO converts a User entity to UserDTO.
+func ConvertUserToDTO(user *ent.User) UserDTO {
+	return UserDTO{
+		ID:          user.ID,
+		Email:       user.Email,
+		DisplayName: user.DisplayName,
+		CreatedAt:   user.CreatedAt,
+		UpdatedAt:   user.UpdatedAt,
+	}
+}
+
+// SignupForm is the structure for registering a new User.
+type SignupForm struct {
+	Email       string `json:"email" validate:"required,email"`
+	DisplayName string `json:"display_name" validate:"required,max=100"`
+	Password    string `json:"password" validate:"required,min=8,max=72" sensitive:"true"`
+}
+
+// UpdateUserForm is the structure for updating a User profile.
+// Password is only changed when it is not empty, and then CurrentPassword is required.
+type UpdateUserForm struct {
+	DisplayName     string `json:"display_name" validate:"required,max=100"`
+	Password        string `json:"password" validate:"omitempty,min=8,max=72" sensitive:"true"`
+	CurrentPassword string `json:"current_password" validate:"required_with=Password" sensitive:"true"`
+}
@@ -0,0 +1,95 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+	"todo-api-golang/ent"
+)
+
+// WebhookDTO is a Data Transfer Object for Webhook entity.
+// Secret is only returned when the webhook is created or its secret is changed.
+type WebhookDTO struct {
+	ID        int       `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Active    bool      `json:"active"`
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ConvertWebhookToDTO converts a Webhook entity to WebhookDTO without its secret.
+func ConvertWebhookToDTO(webhook *ent.Webhook) WebhookDTO {
+	events := webhook.Events
+	if events == nil {
+		events = []string{}
+	}
+	return WebhookDTO{
+		ID:        webhook.ID,
+		URL:       webhook.URL,
+		Events:    events,
+		Active:    webhook.Active,
+		CreatedAt: webhook.CreatedAt,
+		UpdatedAt: webhook.UpdatedAt,
+	}
+}
+
+// WebhookForm is the structure for creating or updating a Webhook.
+// An empty Events list subscribes to every event type. A secret is generated when none is given
+// on creation, and an empty Secret keeps the current secret on update. Active defaults to true.
+type WebhookForm struct {
+	URL    string   `json:"url" validate:"required,http_url,max=2048"`
+	Secret string   `json:"secret" validate:"omitempty,min=16,max=255" sensitive:"true"`
+	Events []string `json:"events" validate:"omitempty,dive,oneof=todo.created todo.updated todo.status_changed todo.deleted todo.restored"`
+	Active *bool    `json:"active"`
+}
+
+// WebhookDeliveryDTO is an entry of the delivery log of a Webhook.
+// Status is one of pending, succeeded and failed.
+type WebhookDeliveryDTO struct {
+	ID             int             `json:"id"`
+	EventID        int             `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	ResponseStatus *int            `json:"response_status,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+}
+
+// ConvertWebhookDeliveryToDTO converts a WebhookDelivery entity to WebhookDeliveryDTO.
+func ConvertWebhookDeliveryToDTO(delivery *ent.WebhookDelivery) WebhookDeliveryDTO {
+	return WebhookDeliveryDTO{
+		ID:             delivery.ID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+		Payload:        delivery.Payload,
+	}
+}
+
+// WebhookDispatch is a delivery claimed by the webhook worker, with what is needed to send it.
+type WebhookDispatch struct {
+	DeliveryID int
+	URL        string
+	Secret     string
+	EventType  string
+	Payload    []byte
+}
+
+// WebhookAttempt is the outcome of sending a delivery. ResponseStatus is empty when no response
+// was received, and Error describes why the attempt failed.
+type WebhookAttempt struct {
+	ResponseStatus *int
+	Error          string
+	At             time.Time
+}
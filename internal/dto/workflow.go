@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+	"todo-api-golang/ent"
+)
+
+// WorkflowDTO describes the configured todo workflow.
+type WorkflowDTO struct {
+	States []WorkflowStateDTO `json:"states"`
+	// Complete is the state a todo enters when it is completed automatically by its subtasks.
+	Complete string `json:"complete"`
+}
+
+// WorkflowStateDTO is a single state of the workflow and the states it may move to.
+type WorkflowStateDTO struct {
+	Name    string   `json:"name"`
+	Started bool     `json:"started"`
+	Done    bool     `json:"done"`
+	Next    []string `json:"next"`
+}
+
+// TodoTransitionDTO is a recorded status change of a todo.
+// From is empty for the initial status of the todo.
+type TodoTransitionDTO struct {
+	ID        int       `json:"id"`
+	From      string    `json:"from,omitempty"`
+	To        string    `json:"to"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ConvertTodoTransitionToDTO converts a TodoTransition entity to TodoTransitionDTO.
+func ConvertTodoTransitionToDTO(transition *ent.TodoTransition) TodoTransitionDTO {
+	transitionDTO := TodoTransitionDTO{
+		ID:        transition.ID,
+		To:        transition.ToStatus,
+		CreatedAt: transition.CreatedAt,
+	}
+	if transition.FromStatus != nil {
+		transitionDTO.From = *transition.FromStatus
+	}
+	return transitionDTO
+}
@@ -0,0 +1,72 @@
+// Package events publishes the changes of todos to subscribers in real time.
+//
+// Every recorded revision of a todo (see internal/revision) becomes an event once the
+// transaction that wrote it has committed. The event ID is the revision ID, so a subscriber
+// that lost its connection can catch up from the revisions recorded after the last event it saw.
+// Events are distributed by a Broker; the in-process one can be replaced with an external broker
+// when the API runs on more than one instance.
+package events
+
+import (
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/todorevision"
+)
+
+// Event types.
+const (
+	TypeCreated       = "todo.created"
+	TypeUpdated       = "todo.updated"
+	TypeStatusChanged = "todo.status_changed"
+	TypeDeleted       = "todo.deleted"
+	TypeRestored      = "todo.restored"
+)
+
+// Event is a change of a todo owned by UserID.
+type Event struct {
+	ID       int
+	Type     string
+	UserID   int
+	TodoID   int
+	Revision *ent.TodoRevision
+}
+
+// New creates the event of a revision of a todo owned by userID.
+func New(userID int, revision *ent.TodoRevision) Event {
+	return Event{
+		ID:       revision.ID,
+		Type:     TypeOf(revision.Action),
+		UserID:   userID,
+		TodoID:   revision.TodoID,
+		Revision: revision,
+	}
+}
+
+// TypeOf returns the event type of a revision action.
+func TypeOf(action todorevision.Action) string {
+	switch action {
+	case todorevision.ActionCreate:
+		return TypeCreated
+	case todorevision.ActionStatus:
+		return TypeStatusChanged
+	case todorevision.ActionDelete:
+		return TypeDeleted
+	case todorevision.ActionRestore:
+		return TypeRestored
+	}
+	return TypeUpdated
+}
+
+// Broker distributes events to the subscribers of their user.
+type Broker interface {
+	Publish(event Event)
+	// Subscribe starts receiving the events of the user published from now on.
+	Subscribe(userID int) Subscription
+}
+
+// Subscription receives the events of a single user.
+type Subscription interface {
+	// Events is closed when the subscription ends, either by Close or because the
+	// subscriber did not keep up with the events.
+	Events() <-chan Event
+	Close()
+}
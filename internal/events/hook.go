@@ -0,0 +1,54 @@
+package events
+
+import (
+	"context"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/hook"
+	"todo-api-golang/ent/todo"
+)
+
+// Register publishes an event to the broker for every todo revision created with the client.
+func Register(client *ent.Client, broker Broker) {
+	client.TodoRevision.Use(Hook(broker))
+}
+
+// Hook publishes the event of each created revision. Revisions written in a transaction are
+// published after it commits, so that subscribers never see changes that were rolled back.
+func Hook(broker Broker) ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.TodoRevisionFunc(func(ctx context.Context, m *ent.TodoRevisionMutation) (ent.Value, error) {
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return value, err
+			}
+			revision, ok := value.(*ent.TodoRevision)
+			if !ok {
+				return value, nil
+			}
+			owner, err := m.Client().Todo.Query().
+				Where(todo.ID(revision.TodoID)).
+				Select(todo.FieldUserID).
+				Only(ctx)
+			if err != nil {
+				return nil, err
+			}
+			event := New(owner.UserID, revision)
+
+			tx, err := m.Tx()
+			if err != nil {
+				broker.Publish(event)
+				return value, nil
+			}
+			tx.OnCommit(func(next ent.Committer) ent.Committer {
+				return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+					if err := next.Commit(ctx, tx); err != nil {
+						return err
+					}
+					broker.Publish(event)
+					return nil
+				})
+			})
+			return value, nil
+		})
+	}, ent.OpCreate)
+}
@@ -0,0 +1,17 @@
+package events
+
+import "sync"
+
+var (
+	broker     Broker
+	brokerOnce sync.Once
+)
+
+// InitBroker는 프로세스 내 브로커를 한 번만 생성하고, 이후 호출에서는 같은 브로커를 반환합니다.
+// 외부 브로커로 교체할 때는 이 함수가 반환하는 구현만 바꾸면 됩니다.
+func InitBroker() Broker {
+	brokerOnce.Do(func() {
+		broker = NewMemoryBroker(DefaultBufferSize)
+	})
+	return broker
+}
@@ -0,0 +1,83 @@
+package events
+
+import "sync"
+
+// DefaultBufferSize is the number of events a subscription of the in-process broker can fall behind.
+const DefaultBufferSize = 64
+
+// memoryBroker distributes events to the subscribers of this process.
+type memoryBroker struct {
+	mu          sync.RWMutex
+	subscribers map[int]map[*memorySubscription]struct{}
+	bufferSize  int
+}
+
+// NewMemoryBroker creates an in-process Broker.
+// A subscriber that falls more than bufferSize events behind is dropped and has to resubscribe.
+func NewMemoryBroker(bufferSize int) Broker {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	return &memoryBroker{
+		subscribers: make(map[int]map[*memorySubscription]struct{}),
+		bufferSize:  bufferSize,
+	}
+}
+
+func (b *memoryBroker) Publish(event Event) {
+	var slow []*memorySubscription
+
+	b.mu.RLock()
+	for sub := range b.subscribers[event.UserID] {
+		select {
+		case sub.events <- event:
+		default:
+			slow = append(slow, sub)
+		}
+	}
+	b.mu.RUnlock()
+
+	// 이벤트를 놓친 구독은 닫아서 클라이언트가 마지막 이벤트부터 다시 구독하게 합니다.
+	for _, sub := range slow {
+		sub.Close()
+	}
+}
+
+func (b *memoryBroker) Subscribe(userID int) Subscription {
+	sub := &memorySubscription{
+		broker: b,
+		userID: userID,
+		events: make(chan Event, b.bufferSize),
+	}
+	b.mu.Lock()
+	if b.subscribers[userID] == nil {
+		b.subscribers[userID] = make(map[*memorySubscription]struct{})
+	}
+	b.subscribers[userID][sub] = struct{}{}
+	b.mu.Unlock()
+	return sub
+}
+
+type memorySubscription struct {
+	broker *memoryBroker
+	userID int
+	events chan Event
+	once   sync.Once
+}
+
+func (s *memorySubscription) Events() <-chan Event {
+	return s.events
+}
+
+func (s *memorySubscription) Close() {
+	s.once.Do(func() {
+		b := s.broker
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers[s.userID], s)
+		if len(b.subscribers[s.userID]) == 0 {
+			delete(b.subscribers, s.userID)
+		}
+		close(s.events)
+	})
+}
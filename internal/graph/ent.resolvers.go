@@ -0,0 +1,66 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.50
+
+import (
+	"context"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/todo"
+
+	"entgo.io/contrib/entgql"
+)
+
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, id int) (ent.Noder, error) {
+	return r.Todo(ctx, id)
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, ids []int) ([]ent.Noder, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	todos, err := r.client.Todo.Query().
+		Where(todo.IDIn(ids...), todo.UserID(uid), todo.DeletedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*ent.Todo, len(todos))
+	for _, t := range todos {
+		byID[t.ID] = t
+	}
+	nodes := make([]ent.Noder, len(ids))
+	for i, id := range ids {
+		if t, ok := byID[id]; ok {
+			nodes[i] = t
+		}
+	}
+	return nodes, nil
+}
+
+// Todos is the resolver for the todos field.
+func (r *queryResolver) Todos(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoConnection, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := r.client.Todo.Query().Where(todo.UserID(uid), todo.DeletedAtIsNil())
+	return paginate(ctx, query, after, first, before, last, orderBy, where)
+}
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// Todo returns TodoResolver implementation.
+func (r *Resolver) Todo() TodoResolver { return &todoResolver{r} }
+
+// TodoRevision returns TodoRevisionResolver implementation.
+func (r *Resolver) TodoRevision() TodoRevisionResolver { return &todoRevisionResolver{r} }
+
+type queryResolver struct{ *Resolver }
+type todoResolver struct{ *Resolver }
+type todoRevisionResolver struct{ *Resolver }
@@ -0,0 +1,29 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graph
+
+import (
+	"time"
+)
+
+// A changed field of a todo. The old and new values are JSON encoded.
+type FieldChange struct {
+	Field string  `json:"field"`
+	Old   *string `json:"old,omitempty"`
+	New   *string `json:"new,omitempty"`
+}
+
+type Subscription struct {
+}
+
+type TodoInput struct {
+	Title        string     `json:"title"`
+	Description  *string    `json:"description,omitempty"`
+	Status       string     `json:"status"`
+	Priority     *int       `json:"priority,omitempty"`
+	ProjectID    *int       `json:"projectID,omitempty"`
+	Tags         []string   `json:"tags,omitempty"`
+	AutoComplete *bool      `json:"autoComplete,omitempty"`
+	DueAt        *time.Time `json:"dueAt,omitempty"`
+	RemindAt     *time.Time `json:"remindAt,omitempty"`
+}
@@ -0,0 +1,10 @@
+package graph
+
+import _ "embed"
+
+// PlaygroundHTML is a GraphiQL page for the GraphQL endpoint it is served next to.
+// The access token is entered in the Authorization header of the headers editor;
+// subscriptions pass it as the access_token query parameter of the WebSocket.
+//
+//go:embed playground.html
+var PlaygroundHTML []byte
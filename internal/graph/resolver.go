@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/schema"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/service"
+	response "todo-api-golang/middleware"
+
+	"entgo.io/contrib/entgql"
+)
+
+// Resolver is the root resolver. The resolvers of the fields are in *.resolvers.go,
+// generated by gqlgen from the schema.
+type Resolver struct {
+	client *ent.Client
+	todos  service.TodoService
+	events service.EventService
+}
+
+// result returns a function loading the todo returned by a TodoService method, so that its edges
+// can be resolved like the ones of the todos of a query.
+func (r *Resolver) result(ctx context.Context, uid int) func(*dto.TodoDTO, error) (*ent.Todo, error) {
+	return func(todoDTO *dto.TodoDTO, err error) (*ent.Todo, error) {
+		if err != nil {
+			return nil, err
+		}
+		return r.client.Todo.Query().
+			Where(todo.ID(todoDTO.ID), todo.UserID(uid)).
+			Only(ctx)
+	}
+}
+
+// form converts the input into the form of TodoService and validates it like a request body.
+func (in TodoInput) form() (dto.TodoForm, error) {
+	form := dto.TodoForm{
+		Title:        in.Title,
+		Status:       in.Status,
+		ProjectID:    in.ProjectID,
+		Tags:         in.Tags,
+		AutoComplete: in.AutoComplete != nil && *in.AutoComplete,
+		DueAt:        in.DueAt,
+		RemindAt:     in.RemindAt,
+	}
+	if in.Description != nil {
+		form.Description = *in.Description
+	}
+	if in.Priority != nil {
+		form.Priority = dto.PriorityName(*in.Priority)
+	}
+	return form, response.Validate(&form)
+}
+
+// paginate returns a page of the todos of query. Like the REST API, pages hold DefaultListLimit
+// todos unless first or last is given, and at most MaxListLimit.
+func paginate(ctx context.Context, query *ent.TodoQuery, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoConnection, error) {
+	for _, n := range []*int{first, last} {
+		if n != nil && (*n < 0 || *n > service.MaxListLimit) {
+			return nil, apperror.Newf(apperror.CodeInvalidQuery, "first and last must be between 0 and %d", service.MaxListLimit)
+		}
+	}
+	if first == nil && last == nil {
+		limit := service.DefaultListLimit
+		first = &limit
+	}
+	return query.Paginate(ctx, after, first, before, last,
+		ent.WithTodoOrder(orderBy),
+		ent.WithTodoFilter(where.Filter),
+	)
+}
+
+// fieldChanges returns the changes ordered by field name, with the values encoded as JSON.
+func fieldChanges(changes map[string]schema.FieldChange) []*FieldChange {
+	result := make([]*FieldChange, 0, len(changes))
+	for field, change := range changes {
+		result = append(result, &FieldChange{
+			Field: field,
+			Old:   jsonValue(change.Old),
+			New:   jsonValue(change.New),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Field < result[j].Field })
+	return result
+}
+
+// jsonValue encodes a recorded value; nil stays null.
+func jsonValue(value interface{}) *string {
+	if value == nil {
+		return nil
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	encoded := string(raw)
+	return &encoded
+}
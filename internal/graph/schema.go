@@ -0,0 +1,135 @@
+// Package graph serves the todos over GraphQL.
+//
+// The schema is generated from the ent schema by entgql (ent.graphql): the Todo type with its
+// edges and the todos query, a Relay connection filtered by TodoWhereInput and ordered by TodoOrder.
+// todo.graphql adds the fields that are not entities: a mutation per operation of service.TodoService,
+// the todoChanged subscription to the change feed of service.EventService, and the trash, progress
+// and history of the todos. The resolvers are generated by gqlgen, see gqlgen.yml.
+//
+// Every operation acts on behalf of the user in the request context. Queries read the user's todos
+// through ent; mutations go through TodoService, so they are validated and recorded like the REST API.
+package graph
+
+//go:generate go run -mod=mod github.com/99designs/gqlgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+	"todo-api-golang/edge/log"
+	"todo-api-golang/ent"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/service"
+	response "todo-api-golang/middleware"
+	"todo-api-golang/middleware/auth"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gorilla/websocket"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// MaxComplexity limits the number of fields a query may select, counting the fields of every list once.
+const MaxComplexity = 500
+
+// NewSchema binds the generated schema to the services.
+func NewSchema(client *ent.Client, todos service.TodoService, events service.EventService) graphql.ExecutableSchema {
+	return NewExecutableSchema(Config{
+		Resolvers: &Resolver{client: client, todos: todos, events: events},
+	})
+}
+
+// NewServer serves the schema: operations are sent with POST and subscriptions over a WebSocket
+// speaking graphql-transport-ws, or the older graphql-ws.
+func NewServer(schema graphql.ExecutableSchema) *handler.Server {
+	srv := handler.New(schema)
+	srv.AddTransport(transport.Websocket{
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+		KeepAlivePingInterval: 10 * time.Second,
+		InitTimeout:           10 * time.Second,
+	})
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.FixedComplexityLimit(MaxComplexity))
+	srv.AroundFields(func(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+		res, err := next(ctx)
+		return res, wrapError(err)
+	})
+	srv.SetErrorPresenter(presentError)
+	return srv
+}
+
+// wrapError converts an error of a resolver into an *apperror.Error. Internal errors are logged and
+// only their generic message is returned.
+func wrapError(err error) error {
+	if err == nil {
+		return nil
+	}
+	appErr := response.AppError(err)
+	if response.StatusOf(appErr.Code) >= 500 {
+		log.Logger.Error().Err(err).Str("code", string(appErr.Code)).Msg("graphql resolver failed")
+	}
+	return appErr
+}
+
+// presentError puts the error code of the API in the extensions of an error, so that clients can
+// handle errors the same way as with the REST endpoints. Errors of the request itself, such as
+// syntax and validation errors, are returned as they are.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) {
+		return gqlErr
+	}
+	gqlErr.Message = appErr.Message
+	gqlErr.Extensions = map[string]interface{}{"code": appErr.Code}
+	if len(appErr.Fields) > 0 {
+		gqlErr.Extensions["fields"] = appErr.Fields
+	}
+	return gqlErr
+}
+
+// userID returns the authenticated user of the request.
+func userID(ctx context.Context) (int, error) {
+	id, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return 0, apperror.New(apperror.CodeUnauthorized, "Unauthorized")
+	}
+	return id, nil
+}
+
+// MarshalID encodes the integer ID of an entity as a GraphQL ID.
+func MarshalID(id int) graphql.Marshaler {
+	return graphql.MarshalIntID(id)
+}
+
+// UnmarshalID decodes a GraphQL ID into the integer ID of an entity.
+func UnmarshalID(v interface{}) (int, error) {
+	id, err := graphql.UnmarshalIntID(v)
+	if err != nil || id < 1 {
+		return 0, apperror.Newf(apperror.CodeInvalidParameter, "Invalid ID %q", fmt.Sprint(v))
+	}
+	return id, nil
+}
+
+// MarshalPriority encodes a stored priority as the name of the Priority enum.
+func MarshalPriority(value int) graphql.Marshaler {
+	return graphql.MarshalString(dto.PriorityName(value))
+}
+
+// UnmarshalPriority decodes a value of the Priority enum into the stored priority.
+func UnmarshalPriority(v interface{}) (int, error) {
+	name, _ := v.(string)
+	value, ok := dto.PriorityValue(name)
+	if !ok || name == "" {
+		return 0, apperror.Newf(apperror.CodeInvalidParameter, "Invalid priority %q", fmt.Sprint(v))
+	}
+	return value, nil
+}
@@ -0,0 +1,281 @@
+package graph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/enttest"
+	"todo-api-golang/internal/revision"
+	"todo-api-golang/internal/service"
+	"todo-api-golang/internal/workflow"
+	response "todo-api-golang/middleware"
+	"todo-api-golang/middleware/auth"
+	"todo-api-golang/util"
+
+	"github.com/go-playground/validator/v10"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// graphFixture holds two users. The first one has the todos report, at version 2 after the done
+// subtask draft was added, and trashed, which is in the trash; the other user has the todo foreign.
+type graphFixture struct {
+	client                          *ent.Client
+	server                          http.Handler
+	userID                          int
+	report, draft, trashed, foreign int
+}
+
+func newGraphFixture(t *testing.T) *graphFixture {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	revision.Register(client)
+
+	wf, err := workflow.Load("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = response.RegisterValidation("todo_status", func(fl validator.FieldLevel) bool {
+		return wf.IsState(fl.Field().String())
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user := client.User.Create().SetEmail("graph@example.com").SetDisplayName("graph").SetPasswordHash("x").SaveX(ctx)
+	other := client.User.Create().SetEmail("other@example.com").SetDisplayName("other").SetPasswordHash("x").SaveX(ctx)
+	work := client.Tag.Create().SetName("work").SetUserID(user.ID).SaveX(ctx)
+
+	f := &graphFixture{
+		client: client,
+		server: NewServer(NewSchema(client, service.NewTodoService(client, wf, util.Config{}), nil)),
+		userID: user.ID,
+	}
+	f.report = client.Todo.Create().SetTitle("report").SetPosition("a0").SetPriority(2).SetUserID(user.ID).AddTags(work).SaveX(ctx).ID
+	f.draft = client.Todo.Create().SetTitle("draft").SetPosition("a1").SetStatus("COMPLETED").SetCompletedAt(time.Now()).
+		SetParentID(f.report).SetUserID(user.ID).SaveX(ctx).ID
+	f.trashed = client.Todo.Create().SetTitle("trashed").SetPosition("a2").SetDeletedAt(time.Now()).SetUserID(user.ID).SaveX(ctx).ID
+	f.foreign = client.Todo.Create().SetTitle("foreign").SetPosition("a0").SetUserID(other.ID).SaveX(ctx).ID
+	return f
+}
+
+// graphError is an error of a GraphQL response.
+type graphError struct {
+	Message    string
+	Extensions map[string]interface{}
+}
+
+// exec runs an operation on behalf of the user and decodes the data of the response into data.
+func (f *graphFixture) exec(t *testing.T, userID int, query string, variables map[string]interface{}, data interface{}) []graphError {
+	t.Helper()
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(auth.WithUserID(req.Context(), userID))
+	rec := httptest.NewRecorder()
+	f.server.ServeHTTP(rec, req)
+
+	var resp struct {
+		Data   json.RawMessage
+		Errors []graphError
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decoding %s: %v", rec.Body.String(), err)
+	}
+	if data != nil && len(resp.Data) > 0 && string(resp.Data) != "null" {
+		if err := json.Unmarshal(resp.Data, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return resp.Errors
+}
+
+func id(value int) string {
+	return strconv.Itoa(value)
+}
+
+func TestTodosOfTheUser(t *testing.T) {
+	f := newGraphFixture(t)
+
+	var data struct {
+		Todos struct {
+			TotalCount int
+			Edges      []struct {
+				Node struct {
+					ID       string
+					Title    string
+					Priority string
+					Tags     []struct{ Name string }
+					Progress *struct{ Done, Total int }
+					Subtasks []struct{ Title string }
+				}
+			}
+		}
+		Trash struct {
+			Edges []struct{ Node struct{ Title string } }
+		}
+	}
+	errs := f.exec(t, f.userID, `{
+		todos(orderBy: [{field: POSITION}]) {
+			totalCount
+			edges { node { id title priority tags { name } progress { done total } subtasks { title } } }
+		}
+		trash { edges { node { title } } }
+	}`, nil, &data)
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	if data.Todos.TotalCount != 2 || len(data.Todos.Edges) != 2 {
+		t.Fatalf("todos = %+v, want report and draft", data.Todos)
+	}
+	report := data.Todos.Edges[0].Node
+	if report.ID != id(f.report) || report.Priority != "HIGH" || len(report.Tags) != 1 || report.Tags[0].Name != "work" {
+		t.Errorf("report = %+v", report)
+	}
+	if report.Progress == nil || *report.Progress != (struct{ Done, Total int }{1, 1}) {
+		t.Errorf("progress of report = %+v, want 1 of 1", report.Progress)
+	}
+	if len(report.Subtasks) != 1 || report.Subtasks[0].Title != "draft" {
+		t.Errorf("subtasks of report = %+v, want draft", report.Subtasks)
+	}
+	if len(data.Trash.Edges) != 1 || data.Trash.Edges[0].Node.Title != "trashed" {
+		t.Errorf("trash = %+v, want trashed", data.Trash.Edges)
+	}
+}
+
+func TestTodosWhere(t *testing.T) {
+	f := newGraphFixture(t)
+
+	var data struct {
+		Todos struct {
+			Edges []struct{ Node struct{ Title string } }
+		}
+	}
+	errs := f.exec(t, f.userID, `query($where: TodoWhereInput) {
+		todos(where: $where) { edges { node { title } } }
+	}`, map[string]interface{}{
+		"where": map[string]interface{}{
+			"priorityGTE": "HIGH",
+			"hasTagsWith": []interface{}{map[string]interface{}{"name": "work"}},
+		},
+	}, &data)
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	if len(data.Todos.Edges) != 1 || data.Todos.Edges[0].Node.Title != "report" {
+		t.Errorf("todos = %+v, want report", data.Todos.Edges)
+	}
+}
+
+func TestTodosOfOtherUsersAreNotFound(t *testing.T) {
+	f := newGraphFixture(t)
+
+	var data struct {
+		Todo  *struct{ Title string }
+		Node  *struct{ ID string }
+		Nodes []*struct{ ID string }
+	}
+	errs := f.exec(t, f.userID, `query($foreign: ID!, $trashed: ID!) {
+		todo(id: $foreign) { title }
+		node(id: $foreign) { id }
+		nodes(ids: [$foreign, $trashed]) { id }
+	}`, map[string]interface{}{"foreign": id(f.foreign), "trashed": id(f.trashed)}, &data)
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	if data.Todo != nil || data.Node != nil || !reflect.DeepEqual(data.Nodes, []*struct{ ID string }{nil, nil}) {
+		t.Errorf("data = %+v, want no todos", data)
+	}
+
+	errs = f.exec(t, f.userID, `mutation($id: ID!) { updateTodoStatus(id: $id, status: "PROGRESS") { id } }`,
+		map[string]interface{}{"id": id(f.foreign)}, nil)
+	if len(errs) != 1 || errs[0].Extensions["code"] != "TODO_NOT_FOUND" {
+		t.Errorf("errors = %+v, want TODO_NOT_FOUND", errs)
+	}
+}
+
+func TestMutationsGoThroughTheService(t *testing.T) {
+	f := newGraphFixture(t)
+
+	var data struct {
+		UpdateTodoStatus struct {
+			Status    string
+			StartedAt *time.Time
+			Version   int
+			Revisions []struct {
+				Action  string
+				Changes []struct {
+					Field string
+					New   *string
+				}
+			}
+		}
+	}
+	errs := f.exec(t, f.userID, `mutation($id: ID!) {
+		updateTodoStatus(id: $id, status: "PROGRESS", version: 2) {
+			status startedAt version
+			revisions { action changes { field new } }
+		}
+	}`, map[string]interface{}{"id": id(f.report)}, &data)
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	todo := data.UpdateTodoStatus
+	if todo.Status != "PROGRESS" || todo.StartedAt == nil || todo.Version != 3 {
+		t.Errorf("todo = %+v, want PROGRESS, started, at version 3", todo)
+	}
+	if n := len(todo.Revisions); n != 2 || todo.Revisions[n-1].Action != "status" {
+		t.Fatalf("revisions = %+v, want a status revision after the create revision", todo.Revisions)
+	}
+	var fields []string
+	for _, change := range todo.Revisions[1].Changes {
+		fields = append(fields, change.Field)
+	}
+	if !reflect.DeepEqual(fields, []string{"started_at", "status"}) {
+		t.Errorf("changed fields = %v, want started_at and status", fields)
+	}
+
+	// 상태 검증과 낙관적 잠금도 REST API와 같이 적용됩니다.
+	for _, tc := range []struct {
+		query string
+		code  string
+	}{
+		{`mutation($id: ID!) { updateTodoStatus(id: $id, status: "UNKNOWN") { id } }`, "VALIDATION_FAILED"},
+		{`mutation($id: ID!) { updateTodoStatus(id: $id, status: "BLOCKED", version: 2) { id } }`, "PRECONDITION_FAILED"},
+	} {
+		errs := f.exec(t, f.userID, tc.query, map[string]interface{}{"id": id(f.report)}, nil)
+		if len(errs) != 1 || errs[0].Extensions["code"] != tc.code {
+			t.Errorf("%s: errors = %+v, want %s", tc.query, errs, tc.code)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	f := newGraphFixture(t)
+
+	errs := f.exec(t, f.userID, `{ todo(id: "abc") { id } }`, nil, nil)
+	if len(errs) != 1 || errs[0].Extensions["code"] != "INVALID_PARAMETER" {
+		t.Errorf("invalid ID: errors = %+v, want INVALID_PARAMETER", errs)
+	}
+
+	errs = f.exec(t, f.userID, `{ todos(first: 1000) { totalCount } }`, nil, nil)
+	if len(errs) != 1 || errs[0].Extensions["code"] != "INVALID_QUERY" {
+		t.Errorf("first above the limit: errors = %+v, want INVALID_QUERY", errs)
+	}
+
+	errs = f.exec(t, f.userID, `{ todos { edges { node { owner { id } } } } }`, nil, nil)
+	if len(errs) == 0 {
+		t.Error("the owner of a todo is not part of the schema, but the query succeeded")
+	}
+}
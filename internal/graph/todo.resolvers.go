@@ -0,0 +1,247 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.50
+
+import (
+	"context"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/internal/dto"
+	response "todo-api-golang/middleware"
+
+	"entgo.io/contrib/entgql"
+)
+
+// CreateTodo is the resolver for the createTodo field.
+func (r *mutationResolver) CreateTodo(ctx context.Context, input TodoInput) (*ent.Todo, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form, err := input.form()
+	if err != nil {
+		return nil, err
+	}
+	return r.result(ctx, uid)(r.todos.CreateTodo(ctx, uid, form))
+}
+
+// UpdateTodo is the resolver for the updateTodo field.
+func (r *mutationResolver) UpdateTodo(ctx context.Context, id int, input TodoInput, version *int) (*ent.Todo, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form, err := input.form()
+	if err != nil {
+		return nil, err
+	}
+	return r.result(ctx, uid)(r.todos.UpdateTodo(ctx, uid, id, form, version))
+}
+
+// UpdateTodoStatus is the resolver for the updateTodoStatus field.
+func (r *mutationResolver) UpdateTodoStatus(ctx context.Context, id int, status string, version *int) (*ent.Todo, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := dto.UpdateStatusForm{Status: status}
+	if err := response.Validate(&form); err != nil {
+		return nil, err
+	}
+	return r.result(ctx, uid)(r.todos.UpdateTodoStatus(ctx, uid, id, form.Status, version))
+}
+
+// MoveTodo is the resolver for the moveTodo field.
+func (r *mutationResolver) MoveTodo(ctx context.Context, id int, beforeID *int, afterID *int) (*ent.Todo, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := dto.MoveTodoForm{BeforeID: beforeID, AfterID: afterID}
+	if err := response.Validate(&form); err != nil {
+		return nil, err
+	}
+	return r.result(ctx, uid)(r.todos.MoveTodo(ctx, uid, id, form))
+}
+
+// SetTodoParent is the resolver for the setTodoParent field.
+func (r *mutationResolver) SetTodoParent(ctx context.Context, id int, parentID *int) (*ent.Todo, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.result(ctx, uid)(r.todos.SetTodoParent(ctx, uid, id, parentID))
+}
+
+// CreateSubtask is the resolver for the createSubtask field.
+func (r *mutationResolver) CreateSubtask(ctx context.Context, parentID int, input TodoInput) (*ent.Todo, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form, err := input.form()
+	if err != nil {
+		return nil, err
+	}
+	return r.result(ctx, uid)(r.todos.CreateSubtask(ctx, uid, parentID, form))
+}
+
+// RevertTodo is the resolver for the revertTodo field.
+func (r *mutationResolver) RevertTodo(ctx context.Context, id int, revisionID int) (*ent.Todo, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.result(ctx, uid)(r.todos.RevertTodo(ctx, uid, id, revisionID))
+}
+
+// DeleteTodo is the resolver for the deleteTodo field.
+func (r *mutationResolver) DeleteTodo(ctx context.Context, id int, version *int) (int, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := r.todos.DeleteTodo(ctx, uid, id, version); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// RestoreTodo is the resolver for the restoreTodo field.
+func (r *mutationResolver) RestoreTodo(ctx context.Context, id int) (*ent.Todo, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.result(ctx, uid)(r.todos.RestoreTodo(ctx, uid, id))
+}
+
+// PurgeTodo is the resolver for the purgeTodo field.
+func (r *mutationResolver) PurgeTodo(ctx context.Context, id int) (int, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := r.todos.PurgeTodo(ctx, uid, id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// Todo is the resolver for the todo field.
+func (r *queryResolver) Todo(ctx context.Context, id int) (*ent.Todo, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	todoItem, err := r.client.Todo.Query().
+		Where(todo.ID(id), todo.UserID(uid), todo.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return todoItem, err
+}
+
+// Trash is the resolver for the trash field.
+func (r *queryResolver) Trash(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoConnection, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := r.client.Todo.Query().Where(todo.UserID(uid), todo.DeletedAtNotNil())
+	return paginate(ctx, query, after, first, before, last, orderBy, where)
+}
+
+// Workflow is the resolver for the workflow field.
+func (r *queryResolver) Workflow(ctx context.Context) (*dto.WorkflowDTO, error) {
+	workflow := r.todos.GetWorkflow()
+	return &workflow, nil
+}
+
+// TodoChanged is the resolver for the todoChanged field.
+func (r *subscriptionResolver) TodoChanged(ctx context.Context, lastEventID *int) (<-chan *dto.TodoEventDTO, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var after int
+	if lastEventID != nil {
+		after = *lastEventID
+	}
+	stream, err := r.events.Subscribe(ctx, uid, after)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan *dto.TodoEventDTO)
+	go func() {
+		defer close(events)
+		for event := range stream {
+			select {
+			case events <- &event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+// UID is the resolver for the uid field.
+func (r *todoResolver) UID(ctx context.Context, obj *ent.Todo) (string, error) {
+	return dto.TodoUID(obj), nil
+}
+
+// Subtasks is the resolver for the subtasks field.
+func (r *todoResolver) Subtasks(ctx context.Context, obj *ent.Todo) ([]*ent.Todo, error) {
+	return obj.QueryChildren().
+		Where(todo.DeletedAtIsNil()).
+		Order(todo.ByPosition(), todo.ByID()).
+		All(ctx)
+}
+
+// Progress is the resolver for the progress field.
+func (r *todoResolver) Progress(ctx context.Context, obj *ent.Todo) (*dto.TodoProgress, error) {
+	children, err := obj.QueryChildren().
+		Where(todo.DeletedAtIsNil()).
+		Select(todo.FieldID, todo.FieldCompletedAt).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 진행률은 REST 응답과 같은 규칙으로 계산하도록 DTO 변환에 맡깁니다.
+	withChildren := *obj
+	withChildren.Edges.Children = children
+	return dto.ConvertTodoToDTO(&withChildren).Progress, nil
+}
+
+// Changes is the resolver for the changes field.
+func (r *todoEventResolver) Changes(ctx context.Context, obj *dto.TodoEventDTO) ([]*FieldChange, error) {
+	return fieldChanges(obj.Changes), nil
+}
+
+// Todo is the resolver for the todo field.
+func (r *todoEventResolver) Todo(ctx context.Context, obj *dto.TodoEventDTO) (*ent.Todo, error) {
+	return r.Query().Todo(ctx, obj.TodoID)
+}
+
+// Changes is the resolver for the changes field.
+func (r *todoRevisionResolver) Changes(ctx context.Context, obj *ent.TodoRevision) ([]*FieldChange, error) {
+	return fieldChanges(obj.Changes), nil
+}
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
+// TodoEvent returns TodoEventResolver implementation.
+func (r *Resolver) TodoEvent() TodoEventResolver { return &todoEventResolver{r} }
+
+type mutationResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
+type todoEventResolver struct{ *Resolver }
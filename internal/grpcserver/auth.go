@@ -0,0 +1,97 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"todo-api-golang/edge/token"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/middleware/auth"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// publicMethodPrefixes는 토큰 없이 호출할 수 있는 서비스입니다. 헬스 체크와 리플렉션은 인증 없이 사용할 수 있어야 합니다.
+var publicMethodPrefixes = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+}
+
+// unaryAuthenticator는 REST API의 auth.Authenticator와 같은 방식으로 authorization 메타데이터의 Bearer 토큰을 검증합니다.
+func unaryAuthenticator(maker token.Maker) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isPublicMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, err := authenticate(ctx, maker)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamAuthenticator는 스트리밍 호출에 대해 unaryAuthenticator와 같은 검증을 합니다.
+func streamAuthenticator(maker token.Maker) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isPublicMethod(info.FullMethod) {
+			return handler(srv, stream)
+		}
+		ctx, err := authenticate(stream.Context(), maker)
+		if err != nil {
+			return toStatus(err)
+		}
+		return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// authenticate returns a copy of ctx carrying the ID of the user of the access token in the metadata.
+func authenticate(ctx context.Context, maker token.Maker) (context.Context, error) {
+	accessToken, ok := bearerToken(ctx)
+	if !ok {
+		return nil, apperror.New(apperror.CodeUnauthorized, "Missing bearer token")
+	}
+
+	payload, err := maker.VerifyToken(accessToken, token.TypeAccess)
+	if err != nil {
+		if errors.Is(err, token.ErrExpiredToken) {
+			return nil, apperror.New(apperror.CodeTokenExpired, "Access token has expired")
+		}
+		return nil, apperror.New(apperror.CodeInvalidToken, "Invalid access token")
+	}
+	return auth.WithUserID(ctx, payload.UserID), nil
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", false
+	}
+	scheme, value, found := strings.Cut(values[0], " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	value = strings.TrimSpace(value)
+	return value, value != ""
+}
+
+func isPublicMethod(fullMethod string) bool {
+	for _, prefix := range publicMethodPrefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticatedStream은 인증된 사용자 ID를 담은 컨텍스트를 핸들러에 전달하기 위해 ServerStream을 감쌉니다.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
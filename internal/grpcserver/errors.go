@@ -0,0 +1,68 @@
+package grpcserver
+
+import (
+	"net/http"
+	"todo-api-golang/edge/log"
+	"todo-api-golang/internal/apperror"
+	response "todo-api-golang/middleware"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain is the domain of the ErrorInfo details of the errors.
+const errorDomain = "todo-api-golang"
+
+// statusCodes는 HTTP 상태 코드와 gRPC 상태 코드의 대응표입니다. 목록에 없는 상태는 Internal로 응답합니다.
+var statusCodes = map[int]codes.Code{
+	http.StatusBadRequest:            codes.InvalidArgument,
+	http.StatusRequestEntityTooLarge: codes.InvalidArgument,
+	http.StatusUnsupportedMediaType:  codes.InvalidArgument,
+	http.StatusUnprocessableEntity:   codes.InvalidArgument,
+	http.StatusUnauthorized:          codes.Unauthenticated,
+	http.StatusNotFound:              codes.NotFound,
+	http.StatusConflict:              codes.FailedPrecondition,
+	http.StatusPreconditionFailed:    codes.Aborted,
+}
+
+// toStatus는 서비스 에러를 gRPC 상태로 변환합니다.
+// REST API의 에러 코드는 ErrorInfo의 reason으로, 검증 실패 필드는 BadRequest로 전달합니다.
+func toStatus(err error) error {
+	appErr := response.AppError(err)
+	httpStatus := response.StatusOf(appErr.Code)
+	if httpStatus >= http.StatusInternalServerError {
+		log.Logger.Error().Err(err).Str("code", string(appErr.Code)).Msg("grpc call failed")
+	}
+
+	code, ok := statusCodes[httpStatus]
+	if !ok {
+		code = codes.Internal
+	}
+
+	st := status.New(code, appErr.Message)
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: string(appErr.Code), Domain: errorDomain}}
+	if len(appErr.Fields) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, field := range appErr.Fields {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field.Field,
+				Description: fieldDescription(field),
+			})
+		}
+		details = append(details, badRequest)
+	}
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// fieldDescription describes the failed validation rule of a field.
+func fieldDescription(field apperror.FieldError) string {
+	if field.Param != "" {
+		return field.Rule + "=" + field.Param
+	}
+	return field.Rule
+}
@@ -0,0 +1,35 @@
+// Package grpcserver serves the todos over gRPC.
+//
+// The service definition (proto/todo/v1/todo.proto) mirrors the todo endpoints of the REST API and
+// every call is delegated to service.TodoService, so both APIs share validation, the workflow and
+// optimistic locking. The server also offers the standard health service and server reflection.
+package grpcserver
+
+import (
+	"todo-api-golang/edge/token"
+	"todo-api-golang/internal/service"
+	todov1 "todo-api-golang/proto/todo/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// NewServer creates a gRPC server with the todo service, the health service and server reflection.
+// Calls to the todo service are authenticated with the access tokens of maker.
+func NewServer(todos service.TodoService, maker token.Maker) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryAuthenticator(maker)),
+		grpc.ChainStreamInterceptor(streamAuthenticator(maker)),
+	)
+
+	todov1.RegisterTodoServiceServer(server, NewTodoServer(todos))
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(todov1.TodoService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	reflection.Register(server)
+	return server
+}
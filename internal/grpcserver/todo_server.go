@@ -0,0 +1,310 @@
+package grpcserver
+
+import (
+	"context"
+	"strings"
+	"time"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/service"
+	response "todo-api-golang/middleware"
+	"todo-api-golang/middleware/auth"
+	todov1 "todo-api-golang/proto/todo/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TodoServer implements todov1.TodoServiceServer on top of service.TodoService.
+type TodoServer struct {
+	todov1.UnimplementedTodoServiceServer
+	todos service.TodoService
+}
+
+// NewTodoServer creates a new TodoServer.
+func NewTodoServer(todos service.TodoService) *TodoServer {
+	return &TodoServer{todos: todos}
+}
+
+// userID returns the authenticated user of the call.
+func userID(ctx context.Context) (int, error) {
+	id, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return 0, toStatus(apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+	}
+	return id, nil
+}
+
+func (s *TodoServer) CreateTodo(ctx context.Context, req *todov1.CreateTodoRequest) (*todov1.Todo, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form, err := todoForm(req.GetTodo())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return result(s.todos.CreateTodo(ctx, uid, form))
+}
+
+func (s *TodoServer) GetTodo(ctx context.Context, req *todov1.GetTodoRequest) (*todov1.Todo, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID(req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return result(s.todos.GetTodoByID(ctx, uid, id))
+}
+
+func (s *TodoServer) UpdateTodo(ctx context.Context, req *todov1.UpdateTodoRequest) (*todov1.Todo, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID(req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	form, err := todoForm(req.GetTodo())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return result(s.todos.UpdateTodo(ctx, uid, id, form, optionalVersion(req.Version)))
+}
+
+func (s *TodoServer) UpdateTodoStatus(ctx context.Context, req *todov1.UpdateTodoStatusRequest) (*todov1.Todo, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID(req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	form := dto.UpdateStatusForm{Status: req.GetStatus()}
+	if err := response.Validate(&form); err != nil {
+		return nil, toStatus(err)
+	}
+	return result(s.todos.UpdateTodoStatus(ctx, uid, id, form.Status, optionalVersion(req.Version)))
+}
+
+func (s *TodoServer) DeleteTodo(ctx context.Context, req *todov1.DeleteTodoRequest) (*todov1.DeleteTodoResponse, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID(req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if err := s.todos.DeleteTodo(ctx, uid, id, optionalVersion(req.Version)); err != nil {
+		return nil, toStatus(err)
+	}
+	return &todov1.DeleteTodoResponse{}, nil
+}
+
+func (s *TodoServer) ListTodos(ctx context.Context, req *todov1.ListTodosRequest) (*todov1.ListTodosResponse, error) {
+	uid, err := userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query, err := listQuery(req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	page, err := s.todos.ListTodos(ctx, uid, query)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &todov1.ListTodosResponse{NextPageToken: page.NextCursor}
+	for _, todoDTO := range page.Todos {
+		resp.Todos = append(resp.Todos, toProto(todoDTO))
+	}
+	return resp, nil
+}
+
+func (s *TodoServer) StreamTodos(req *todov1.ListTodosRequest, stream grpc.ServerStreamingServer[todov1.Todo]) error {
+	ctx := stream.Context()
+	uid, err := userID(ctx)
+	if err != nil {
+		return err
+	}
+	query, err := listQuery(req)
+	if err != nil {
+		return toStatus(err)
+	}
+	// 페이지 단위로 읽어 보내므로 결과가 많아도 메모리에 한 페이지만 유지합니다.
+	for {
+		page, err := s.todos.ListTodos(ctx, uid, query)
+		if err != nil {
+			return toStatus(err)
+		}
+		for _, todoDTO := range page.Todos {
+			if err := stream.Send(toProto(todoDTO)); err != nil {
+				return err
+			}
+		}
+		if page.NextCursor == "" {
+			return nil
+		}
+		query.Cursor = page.NextCursor
+	}
+}
+
+// result converts the todo returned by TodoService into a response.
+func result(todoDTO *dto.TodoDTO, err error) (*todov1.Todo, error) {
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProto(*todoDTO), nil
+}
+
+// todoForm converts the input into the form of TodoService and validates it like a request body.
+func todoForm(in *todov1.TodoInput) (dto.TodoForm, error) {
+	form := dto.TodoForm{
+		Title:        in.GetTitle(),
+		Description:  in.GetDescription(),
+		Status:       in.GetStatus(),
+		Priority:     priorityName(in.GetPriority()),
+		Tags:         in.GetTags(),
+		AutoComplete: in.GetAutoComplete(),
+		DueAt:        optionalTime(in.GetDueAt()),
+		RemindAt:     optionalTime(in.GetRemindAt()),
+	}
+	if in != nil && in.ProjectId != nil {
+		projectID, err := parseID(in.GetProjectId())
+		if err != nil {
+			return form, err
+		}
+		form.ProjectID = &projectID
+	}
+	return form, response.Validate(&form)
+}
+
+// listQuery converts a list request into the list query of TodoService.
+func listQuery(req *todov1.ListTodosRequest) (dto.TodoListQuery, error) {
+	query := dto.TodoListQuery{
+		Statuses:        req.GetStatuses(),
+		Search:          strings.TrimSpace(req.GetSearch()),
+		Tags:            req.GetTags(),
+		NoProject:       req.GetNoProject(),
+		IncludeArchived: req.GetIncludeArchived(),
+		RootsOnly:       req.GetTopLevel(),
+		Due:             req.GetDue(),
+		CreatedAfter:    optionalTime(req.GetCreatedAfter()),
+		CreatedBefore:   optionalTime(req.GetCreatedBefore()),
+		UpdatedAfter:    optionalTime(req.GetUpdatedAfter()),
+		UpdatedBefore:   optionalTime(req.GetUpdatedBefore()),
+		Cursor:          req.GetPageToken(),
+	}
+	if req.GetMatchAllTags() {
+		query.TagMode = dto.TagMatchAll
+	}
+	if req.ProjectId != nil {
+		projectID, err := parseID(req.GetProjectId())
+		if err != nil {
+			return query, err
+		}
+		query.ProjectID = &projectID
+	}
+	if tz := req.GetTimeZone(); tz != "" {
+		location, err := time.LoadLocation(tz)
+		if err != nil {
+			return query, apperror.Newf(apperror.CodeInvalidQuery, "Unknown time zone %q", tz)
+		}
+		query.Location = location
+	}
+	for _, term := range req.GetOrderBy() {
+		query.Sort = append(query.Sort, dto.SortField{Field: strings.TrimPrefix(term, "-"), Desc: strings.HasPrefix(term, "-")})
+	}
+	if size := req.GetPageSize(); size != 0 {
+		if size < 1 || size > service.MaxListLimit {
+			return query, apperror.Newf(apperror.CodeInvalidQuery, "page_size must be between 1 and %d", service.MaxListLimit)
+		}
+		query.Limit = int(size)
+	}
+	return query, nil
+}
+
+// toProto converts a todo into its protobuf message.
+func toProto(todoDTO dto.TodoDTO) *todov1.Todo {
+	msg := &todov1.Todo{
+		Id:           int64(todoDTO.ID),
+		Title:        todoDTO.Title,
+		Description:  todoDTO.Description,
+		Status:       todoDTO.Status,
+		Priority:     todov1.Priority(todov1.Priority_value["PRIORITY_"+todoDTO.Priority]),
+		Position:     todoDTO.Position,
+		ProjectId:    optionalID(todoDTO.ProjectID),
+		Tags:         todoDTO.Tags,
+		ParentId:     optionalID(todoDTO.ParentID),
+		AutoComplete: todoDTO.AutoComplete,
+		DueAt:        timestamp(todoDTO.DueAt),
+		RemindAt:     timestamp(todoDTO.RemindAt),
+		StartedAt:    timestamp(todoDTO.StartedAt),
+		CompletedAt:  timestamp(todoDTO.CompletedAt),
+		CreatedAt:    timestamppb.New(todoDTO.CreatedAt),
+		UpdatedAt:    timestamppb.New(todoDTO.UpdatedAt),
+		DeletedAt:    timestamp(todoDTO.DeletedAt),
+		Version:      int64(todoDTO.Version),
+	}
+	if todoDTO.Progress != nil {
+		msg.Progress = &todov1.Progress{Done: int32(todoDTO.Progress.Done), Total: int32(todoDTO.Progress.Total)}
+	}
+	return msg
+}
+
+// priorityName converts a priority into the priority name of the API.
+// PRIORITY_UNSPECIFIED becomes the empty name, which TodoService stores as MEDIUM.
+func priorityName(priority todov1.Priority) string {
+	if priority == todov1.Priority_PRIORITY_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(priority.String(), "PRIORITY_")
+}
+
+// parseID converts the ID of a request into the integer ID of an entity.
+func parseID(id int64) (int, error) {
+	if id < 1 {
+		return 0, apperror.Newf(apperror.CodeInvalidParameter, "Invalid ID %d", id)
+	}
+	return int(id), nil
+}
+
+// optionalID converts an optional integer ID into the ID of a message.
+func optionalID(id *int) *int64 {
+	if id == nil {
+		return nil
+	}
+	value := int64(*id)
+	return &value
+}
+
+// optionalVersion converts the optional version of a request.
+func optionalVersion(version *int64) *int {
+	if version == nil {
+		return nil
+	}
+	value := int(*version)
+	return &value
+}
+
+// optionalTime converts an optional timestamp; nil stays nil.
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	value := ts.AsTime()
+	return &value
+}
+
+// timestamp converts an optional time into a timestamp; nil stays nil.
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"net/http"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/service"
+	response "todo-api-golang/middleware"
+)
+
+type AuthHandlerInterface interface {
+	Login(w http.ResponseWriter, r *http.Request)
+	Refresh(w http.ResponseWriter, r *http.Request)
+	Logout(w http.ResponseWriter, r *http.Request)
+}
+
+type AuthHandler struct {
+	service service.AuthService
+}
+
+// NewAuthHandler creates a new AuthHandler.
+func NewAuthHandler(service service.AuthService) AuthHandlerInterface {
+	return &AuthHandler{service: service}
+}
+
+// Login godoc
+// @Summary Log in
+// @Description Exchange email and password for an access token and a refresh token
+// @Tags auth
+// @Accept  json
+// @Produce  json
+// @Param credentials body dto.LoginForm true "Login form"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/auth/login [post]
+func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var form dto.LoginForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	tokenDTO, err := h.service.Login(r.Context(), form, clientInfo(r))
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Logged in successfully", tokenDTO)
+}
+
+// Refresh godoc
+// @Summary Refresh tokens
+// @Description Exchange a refresh token for a new access token and refresh token. The used refresh token is revoked.
+// @Tags auth
+// @Accept  json
+// @Produce  json
+// @Param token body dto.RefreshTokenForm true "Refresh token"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/auth/refresh [post]
+func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	var form dto.RefreshTokenForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	tokenDTO, err := h.service.Refresh(r.Context(), form.RefreshToken, clientInfo(r))
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Token refreshed successfully", tokenDTO)
+}
+
+// Logout godoc
+// @Summary Log out
+// @Description Revoke the session belonging to the given refresh token
+// @Tags auth
+// @Accept  json
+// @Param token body dto.RefreshTokenForm true "Refresh token"
+// @Success 204 {object} nil "No content"
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/auth/logout [post]
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var form dto.RefreshTokenForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	err := h.service.Logout(r.Context(), form.RefreshToken)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusNoContent, 204, "Logged out successfully", nil)
+}
+
+func clientInfo(r *http.Request) dto.ClientInfo {
+	return dto.ClientInfo{
+		UserAgent: r.UserAgent(),
+		ClientIP:  r.RemoteAddr,
+	}
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"net/http"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/service"
+	"todo-api-golang/internal/todoio"
+	response "todo-api-golang/middleware"
+	"todo-api-golang/middleware/auth"
+)
+
+type CalendarHandlerInterface interface {
+	IssueFeedToken(w http.ResponseWriter, r *http.Request)
+	RevokeFeedToken(w http.ResponseWriter, r *http.Request)
+	GetFeed(w http.ResponseWriter, r *http.Request)
+}
+
+type CalendarHandler struct {
+	service service.CalendarService
+	todos   service.TodoService
+}
+
+// NewCalendarHandler creates a new CalendarHandler.
+func NewCalendarHandler(service service.CalendarService, todos service.TodoService) CalendarHandlerInterface {
+	return &CalendarHandler{service: service, todos: todos}
+}
+
+// IssueFeedToken godoc
+// @Summary Issue a calendar feed token
+// @Description Generate the token of the iCalendar feed of the User and return it with the path of the feed. The token is only returned by this call; issuing a new token revokes the previous one.
+// @Tags calendar
+// @Security BearerAuth
+// @Produce  json
+// @Success 201 {object} response.Response{data=dto.CalendarTokenDTO}
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/calendar/token [post]
+func (h *CalendarHandler) IssueFeedToken(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	tokenDTO, err := h.service.IssueFeedToken(r.Context(), userID)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusCreated, 201, "Calendar feed token issued successfully", tokenDTO)
+}
+
+// RevokeFeedToken godoc
+// @Summary Revoke the calendar feed token
+// @Description Revoke the token of the iCalendar feed of the User; subscriptions using it stop receiving the feed
+// @Tags calendar
+// @Security BearerAuth
+// @Success 204 {object} nil "No content"
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/calendar/token [delete]
+func (h *CalendarHandler) RevokeFeedToken(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	if err := h.service.RevokeFeedToken(r.Context(), userID); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusNoContent, 204, "Calendar feed token revoked successfully", nil)
+}
+
+// GetFeed godoc
+// @Summary Subscribe to the calendar feed
+// @Description Get the Todos of the User as an iCalendar file with a VTODO per Todo, for subscription by calendar apps. The feed is authenticated by the token from POST /api/v1/calendar/token instead of a bearer token. The status is mapped to the STATUS of the VTODO: NEEDS-ACTION until the Todo is started, IN-PROCESS while it is in a started state and COMPLETED (or CANCELLED) once it is done. The filters of GET /api/v1/todos narrow the feed.
+// @Tags calendar
+// @Produce text/calendar
+// @Param token query string true "Calendar feed token"
+// @Param status query string false "Comma separated statuses, e.g. PENDING,PROGRESS"
+// @Param tag query string false "Comma separated tag names"
+// @Param project query string false "Project ID, or none for the todos without a project"
+// @Param due query string false "Due window: overdue, today or week"
+// @Param tz query string false "IANA time zone used for the due window, e.g. Asia/Seoul (default server time zone)"
+// @Param sort query string false "Comma separated fields (id, title, priority, position, created_at, updated_at, due_at), prefix with - for descending, e.g. -created_at,title"
+// @Success 200 {file} file
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/calendar/feed.ics [get]
+func (h *CalendarHandler) GetFeed(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	query, err := parseTodoListQuery(r.URL.Query())
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+	writeExport(w, r, h.todos, userID, dto.FormatICS, query, todoio.Options{})
+}
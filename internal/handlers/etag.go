@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"todo-api-golang/internal/apperror"
+)
+
+// todoETag returns the ETag of a todo, which is its quoted version.
+func todoETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// setTodoETag sets the ETag header of a response carrying a todo.
+func setTodoETag(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", todoETag(version))
+}
+
+// parseIfMatch returns the todo version required by the If-Match header.
+// It returns nil when the header is absent or "*". Only a single ETag is accepted,
+// and an ETag that was not issued by this API can never match.
+func parseIfMatch(r *http.Request) (*int, error) {
+	raw := strings.TrimSpace(r.Header.Get("If-Match"))
+	if raw == "" || raw == "*" {
+		return nil, nil
+	}
+	if strings.Contains(raw, ",") {
+		return nil, apperror.New(apperror.CodeInvalidParameter, `If-Match must be "*" or a single ETag`)
+	}
+	version, ok := parseETag(raw)
+	if !ok {
+		return nil, apperror.New(apperror.CodePreconditionFailed, "If-Match does not match the current ETag")
+	}
+	return &version, nil
+}
+
+// ifNoneMatch reports whether the If-None-Match header matches the ETag,
+// in which case a GET request is answered with 304 Not Modified.
+func ifNoneMatch(r *http.Request, etag string) bool {
+	raw := strings.TrimSpace(r.Header.Get("If-None-Match"))
+	if raw == "" {
+		return false
+	}
+	if raw == "*" {
+		return true
+	}
+	// If-None-Match는 약한 비교를 사용하므로 W/ 접두사는 무시합니다.
+	for _, candidate := range strings.Split(raw, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// parseETag reads the version from a quoted ETag, ignoring a weak W/ prefix.
+func parseETag(raw string) (int, bool) {
+	raw = strings.TrimPrefix(raw, "W/")
+	if len(raw) < 2 || raw[0] != '"' || raw[len(raw)-1] != '"' {
+		return 0, false
+	}
+	version, err := strconv.Atoi(raw[1 : len(raw)-1])
+	return version, err == nil
+}
@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/service"
+	response "todo-api-golang/middleware"
+	"todo-api-golang/middleware/auth"
+
+	"github.com/gorilla/websocket"
+)
+
+// keepAliveInterval is how often an idle stream is kept alive with a comment or a ping.
+const keepAliveInterval = 30 * time.Second
+
+// upgrader accepts WebSocket connections from any origin;
+// the connection is authenticated by the access token, not by cookies.
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+type EventHandlerInterface interface {
+	StreamEvents(w http.ResponseWriter, r *http.Request)
+	StreamEventsWebSocket(w http.ResponseWriter, r *http.Request)
+}
+
+type EventHandler struct {
+	service service.EventService
+}
+
+// NewEventHandler creates a new EventHandler.
+func NewEventHandler(service service.EventService) EventHandlerInterface {
+	return &EventHandler{service: service}
+}
+
+// StreamEvents godoc
+// @Summary Stream the changes of Todos over Server-Sent Events
+// @Description Stream todo.created, todo.updated, todo.status_changed, todo.deleted and todo.restored events for the Todos of the User. Each event carries its ID; reconnect with the Last-Event-ID header (or the last_event_id query parameter) to receive the events missed in between. Browsers that cannot set the Authorization header may pass the access token as the access_token query parameter.
+// @Tags events
+// @Security BearerAuth
+// @Produce  text/event-stream
+// @Param Last-Event-ID header int false "ID of the last event received"
+// @Param last_event_id query int false "ID of the last event received"
+// @Param access_token query string false "Access token, when the Authorization header cannot be set"
+// @Success 200 {object} dto.TodoEventDTO
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/events [get]
+func (h *EventHandler) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	lastEventID, err := parseLastEventID(r)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	stream, err := h.service.Subscribe(r.Context(), userID, lastEventID)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case event, ok := <-stream:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case <-r.Context().Done():
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// StreamEventsWebSocket godoc
+// @Summary Stream the changes of Todos over a WebSocket
+// @Description Upgrade to a WebSocket that receives the same events as the Server-Sent Events stream, one JSON message per event. Pass the last_event_id query parameter to receive the events missed since a previous connection. Browsers may pass the access token as the access_token query parameter.
+// @Tags events
+// @Security BearerAuth
+// @Param last_event_id query int false "ID of the last event received"
+// @Param access_token query string false "Access token, when the Authorization header cannot be set"
+// @Success 101 {object} dto.TodoEventDTO
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/events/ws [get]
+func (h *EventHandler) StreamEventsWebSocket(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	lastEventID, err := parseLastEventID(r)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	// 클라이언트가 연결을 닫으면 구독도 끝나도록 읽기 루프에서 취소합니다.
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	stream, err := h.service.Subscribe(ctx, userID, lastEventID)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade가 이미 에러 응답을 보냈습니다.
+		return
+	}
+	defer conn.Close()
+
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case event, ok := <-stream:
+			if !ok {
+				// 구독이 끊긴 경우 마지막 이벤트부터 다시 연결하도록 알립니다.
+				if ctx.Err() == nil {
+					conn.WriteControl(websocket.CloseMessage,
+						websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "resubscribe with last_event_id"),
+						time.Now().Add(time.Second))
+				}
+				return
+			}
+			if err := conn.WriteJSON(event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(10*time.Second)); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// parseLastEventID reads the event ID to resume from the Last-Event-ID header or the last_event_id
+// query parameter. It returns 0 when neither is set.
+func parseLastEventID(r *http.Request) (int, error) {
+	raw := r.Header.Get("Last-Event-ID")
+	if raw == "" {
+		raw = r.URL.Query().Get("last_event_id")
+	}
+	if raw == "" {
+		return 0, nil
+	}
+	id, err := strconv.Atoi(raw)
+	if err != nil || id < 0 {
+		return 0, apperror.New(apperror.CodeInvalidParameter, "Invalid last event ID")
+	}
+	return id, nil
+}
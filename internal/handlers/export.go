@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"todo-api-golang/edge/log"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/service"
+	"todo-api-golang/internal/todoio"
+	response "todo-api-golang/middleware"
+)
+
+// writeExport streams every todo of the user matching the query in the named format, page by page.
+// The cursor and limit of the query are ignored.
+func writeExport(w http.ResponseWriter, r *http.Request, todos service.TodoService, userID int, format string, query dto.TodoListQuery, options todoio.Options) {
+	encoder, err := todoio.NewEncoder(format, w, options)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+	query.Cursor = ""
+	query.Limit = service.MaxListLimit
+
+	// 첫 페이지를 읽은 뒤에 응답을 시작하므로 잘못된 필터는 에러 응답으로 알릴 수 있습니다.
+	page, err := todos.ListTodos(r.Context(), userID, query)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", todoio.ContentType(format))
+	w.Header().Set("Content-Disposition", `attachment; filename="`+todoio.Filename(format)+`"`)
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	for {
+		for _, todoDTO := range page.Todos {
+			if err := encoder.Encode(todoDTO); err != nil {
+				// 클라이언트가 연결을 끊었습니다.
+				return
+			}
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		query.Cursor = page.NextCursor
+		if page, err = todos.ListTodos(r.Context(), userID, query); err != nil {
+			// 응답을 이미 보내기 시작했으므로 에러를 기록하고 전송을 끝냅니다.
+			log.Logger.Error().Err(err).Int("user_id", userID).Str("format", format).Msg("todo export failed")
+			return
+		}
+	}
+	encoder.Close()
+}
+
+// formatOptions returns the options of the file formats for the user: the statuses of the workflow
+// that formats without a status of their own map to and the names of the user's projects.
+func formatOptions(ctx context.Context, todos service.TodoService, projects service.ProjectService, userID int) (todoio.Options, error) {
+	projectDTOs, err := projects.ListProjects(ctx, userID, nil)
+	if err != nil {
+		return todoio.Options{}, err
+	}
+	names := make(map[int]string, len(projectDTOs))
+	for _, project := range projectDTOs {
+		names[project.ID] = project.Name
+	}
+	return todoio.Options{Defaults: workflowDefaults(todos.GetWorkflow()), Projects: names}, nil
+}
+
+// workflowDefaults returns the statuses of todos whose file does not give one: the first state
+// of the workflow for open todos, the first started state for todos in progress, and the complete
+// state for done ones. Cancelled todos get the CANCELLED state when the workflow has a done state
+// of that name.
+func workflowDefaults(workflow dto.WorkflowDTO) todoio.Defaults {
+	defaults := todoio.Defaults{
+		Open:      workflow.States[0].Name,
+		Started:   workflow.States[0].Name,
+		Done:      workflow.Complete,
+		Cancelled: workflow.Complete,
+	}
+	for i := len(workflow.States) - 1; i >= 0; i-- {
+		state := workflow.States[i]
+		if state.Started && !state.Done {
+			defaults.Started = state.Name
+		}
+		if state.Done && state.Name == "CANCELLED" {
+			defaults.Cancelled = state.Name
+		}
+	}
+	return defaults
+}
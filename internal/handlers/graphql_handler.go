@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+	"todo-api-golang/internal/graph"
+	response "todo-api-golang/middleware"
+)
+
+type GraphQLHandlerInterface interface {
+	Query(w http.ResponseWriter, r *http.Request)
+	Subscribe(w http.ResponseWriter, r *http.Request)
+	Playground(w http.ResponseWriter, r *http.Request)
+}
+
+type GraphQLHandler struct {
+	server http.Handler
+}
+
+// NewGraphQLHandler creates a new GraphQLHandler serving operations with the given GraphQL server.
+func NewGraphQLHandler(server http.Handler) GraphQLHandlerInterface {
+	return &GraphQLHandler{server: server}
+}
+
+// Query godoc
+// @Summary Execute a GraphQL query or mutation
+// @Description Execute a GraphQL operation against the todos of the User. The schema is generated from the ent schema: the todos query returns a Relay connection filtered by TodoWhereInput and ordered by TodoOrder, there is a mutation per todo operation, and the todoChanged subscription is served over WebSocket at /graphql/ws. Errors of resolvers are returned in the errors list with the error code in extensions.code.
+// @Tags graphql
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param request body dto.GraphQLRequest true "GraphQL request"
+// @Success 200 {object} object
+// @Failure 400 {object} object
+// @Failure 401 {object} response.Response
+// @Failure 422 {object} object
+// @Router /graphql [post]
+func (h *GraphQLHandler) Query(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, response.MaxBodyBytes)
+	h.server.ServeHTTP(w, r)
+}
+
+// Subscribe godoc
+// @Summary Execute GraphQL subscriptions over a WebSocket
+// @Description Upgrade to a WebSocket speaking the graphql-transport-ws subprotocol, or the older graphql-ws. Send connection_init, then a subscribe message per operation; the todoChanged subscription streams the changes of the Todos of the User. Browsers may pass the access token as the access_token query parameter.
+// @Tags graphql
+// @Security BearerAuth
+// @Param access_token query string false "Access token, when the Authorization header cannot be set"
+// @Success 101
+// @Failure 400 {object} object
+// @Failure 401 {object} response.Response
+// @Router /graphql/ws [get]
+func (h *GraphQLHandler) Subscribe(w http.ResponseWriter, r *http.Request) {
+	h.server.ServeHTTP(w, r)
+}
+
+// Playground serves GraphiQL, an in-browser IDE for the GraphQL endpoint.
+// It is only mounted outside production.
+func (h *GraphQLHandler) Playground(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(graph.PlaygroundHTML)
+}
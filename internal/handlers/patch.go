@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"todo-api-golang/internal/apperror"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+)
+
+// Media types accepted by PATCH requests.
+const (
+	mediaTypeMergePatch = "application/merge-patch+json"
+	mediaTypeJSONPatch  = "application/json-patch+json"
+)
+
+// applyPatch applies a JSON Merge Patch (RFC 7396) or a JSON Patch (RFC 6902) to a JSON document.
+func applyPatch(mediaType string, document, patch []byte) ([]byte, error) {
+	if !json.Valid(patch) {
+		return nil, apperror.New(apperror.CodeMalformedJSON, "Request body contains malformed JSON")
+	}
+	if mediaType == mediaTypeMergePatch {
+		patched, err := jsonpatch.MergePatch(document, patch)
+		if err != nil {
+			return nil, apperror.Wrap(err, apperror.CodeInvalidPatch, "Merge patch must be a JSON object")
+		}
+		return patched, nil
+	}
+
+	operations, err := jsonpatch.DecodePatch(patch)
+	if err != nil {
+		return nil, apperror.Wrap(err, apperror.CodeInvalidPatch, "JSON patch must be an array of operations")
+	}
+	patched, err := operations.Apply(document)
+	if err != nil {
+		// test 연산의 실패와 잘못된 경로는 클라이언트가 고칠 수 있도록 원인을 그대로 알려줍니다.
+		message := "JSON patch could not be applied"
+		if errors.Is(err, jsonpatch.ErrTestFailed) {
+			message = "JSON patch test operation failed"
+		}
+		return nil, apperror.Wrap(err, apperror.CodeInvalidPatch, message+": "+err.Error())
+	}
+	return patched, nil
+}
@@ -0,0 +1,314 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi/v5"
+	"net/http"
+	"strconv"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/service"
+	response "todo-api-golang/middleware"
+	"todo-api-golang/middleware/auth"
+)
+
+type ProjectHandlerInterface interface {
+	CreateProject(w http.ResponseWriter, r *http.Request)
+	ListProjects(w http.ResponseWriter, r *http.Request)
+	GetProject(w http.ResponseWriter, r *http.Request)
+	UpdateProject(w http.ResponseWriter, r *http.Request)
+	DeleteProject(w http.ResponseWriter, r *http.Request)
+	ArchiveProject(w http.ResponseWriter, r *http.Request)
+	UnarchiveProject(w http.ResponseWriter, r *http.Request)
+	RestoreProject(w http.ResponseWriter, r *http.Request)
+}
+
+type ProjectHandler struct {
+	service service.ProjectService
+}
+
+// NewProjectHandler creates a new ProjectHandler.
+func NewProjectHandler(service service.ProjectService) ProjectHandlerInterface {
+	return &ProjectHandler{service: service}
+}
+
+// CreateProject godoc
+// @Summary Create a new Project
+// @Description Create a new Project to group Todos
+// @Tags projects
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param project body dto.ProjectForm true "Project form"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/projects [post]
+func (h *ProjectHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	var form dto.ProjectForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	projectDTO, err := h.service.CreateProject(r.Context(), userID, form)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusCreated, 201, "Project created successfully", projectDTO)
+}
+
+// ListProjects godoc
+// @Summary List all Projects
+// @Description Get the Projects owned by the authenticated User, ordered by name
+// @Tags projects
+// @Security BearerAuth
+// @Produce  json
+// @Param archived query bool false "Only archived (true) or only active (false) projects"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/projects [get]
+func (h *ProjectHandler) ListProjects(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	var archived *bool
+	if raw := r.URL.Query().Get("archived"); raw != "" {
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			response.ResponseError(w, apperror.New(apperror.CodeInvalidQuery, "archived must be a boolean"))
+			return
+		}
+		archived = &value
+	}
+
+	projects, err := h.service.ListProjects(r.Context(), userID, archived)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Projects fetched successfully", projects)
+}
+
+// GetProject godoc
+// @Summary Get a Project by ID
+// @Description Get details of a Project by its ID
+// @Tags projects
+// @Security BearerAuth
+// @Produce  json
+// @Param id path int true "Project ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/projects/{id} [get]
+func (h *ProjectHandler) GetProject(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid project ID"))
+		return
+	}
+
+	projectDTO, err := h.service.GetProjectByID(r.Context(), userID, id)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Project fetched successfully", projectDTO)
+}
+
+// UpdateProject godoc
+// @Summary Update an existing Project
+// @Description Update the name and colour of a Project by its ID
+// @Tags projects
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Project ID"
+// @Param project body dto.ProjectForm true "Project form"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/projects/{id} [put]
+func (h *ProjectHandler) UpdateProject(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid project ID"))
+		return
+	}
+
+	var form dto.ProjectForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	projectDTO, err := h.service.UpdateProject(r.Context(), userID, id, form)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Project updated successfully", projectDTO)
+}
+
+// DeleteProject godoc
+// @Summary Soft delete a Project by ID
+// @Description Move a Project and all of its Todos to the trash
+// @Tags projects
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 204 {object} nil "No content"
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/projects/{id} [delete]
+func (h *ProjectHandler) DeleteProject(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid project ID"))
+		return
+	}
+
+	err = h.service.DeleteProject(r.Context(), userID, id)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusNoContent, 204, "Project deleted successfully", nil)
+}
+
+// ArchiveProject godoc
+// @Summary Archive a Project
+// @Description Archive a Project. Its Todos are hidden from the Todo list unless requested explicitly, and no Todos can be added to it.
+// @Tags projects
+// @Security BearerAuth
+// @Produce  json
+// @Param id path int true "Project ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/projects/{id}/archive [put]
+func (h *ProjectHandler) ArchiveProject(w http.ResponseWriter, r *http.Request) {
+	h.setArchived(w, r, true, "Project archived successfully")
+}
+
+// UnarchiveProject godoc
+// @Summary Unarchive a Project
+// @Description Move an archived Project back to the active Projects
+// @Tags projects
+// @Security BearerAuth
+// @Produce  json
+// @Param id path int true "Project ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/projects/{id}/unarchive [put]
+func (h *ProjectHandler) UnarchiveProject(w http.ResponseWriter, r *http.Request) {
+	h.setArchived(w, r, false, "Project unarchived successfully")
+}
+
+// RestoreProject godoc
+// @Summary Restore a Project from the trash
+// @Description Restore a soft-deleted Project together with the Todos that were deleted with it
+// @Tags projects
+// @Security BearerAuth
+// @Produce  json
+// @Param id path int true "Project ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/projects/{id}/restore [put]
+func (h *ProjectHandler) RestoreProject(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid project ID"))
+		return
+	}
+
+	projectDTO, err := h.service.RestoreProject(r.Context(), userID, id)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Project restored successfully", projectDTO)
+}
+
+func (h *ProjectHandler) setArchived(w http.ResponseWriter, r *http.Request, archived bool, msg string) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid project ID"))
+		return
+	}
+
+	projectDTO, err := h.service.SetProjectArchived(r.Context(), userID, id, archived)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, msg, projectDTO)
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/service"
+)
+
+// parseTodoListQuery reads the filters, sort and pagination parameters of GET /api/v1/todos.
+func parseTodoListQuery(values url.Values) (dto.TodoListQuery, error) {
+	var (
+		query dto.TodoListQuery
+		err   error
+	)
+
+	query.Statuses = splitList(values.Get("status"))
+	query.Search = strings.TrimSpace(values.Get("q"))
+	query.Tags = splitList(values.Get("tag"))
+	query.TagMode = values.Get("tag_mode")
+	query.Due = values.Get("due")
+	if raw := values.Get("project"); raw == "none" {
+		query.NoProject = true
+	} else if raw != "" {
+		projectID, err := strconv.Atoi(raw)
+		if err != nil {
+			return query, apperror.New(apperror.CodeInvalidQuery, `project must be a project ID or "none"`)
+		}
+		query.ProjectID = &projectID
+	}
+	if raw := values.Get("top_level"); raw != "" {
+		if query.RootsOnly, err = strconv.ParseBool(raw); err != nil {
+			return query, apperror.New(apperror.CodeInvalidQuery, "top_level must be a boolean")
+		}
+	}
+	if raw := values.Get("include_archived"); raw != "" {
+		if query.IncludeArchived, err = strconv.ParseBool(raw); err != nil {
+			return query, apperror.New(apperror.CodeInvalidQuery, "include_archived must be a boolean")
+		}
+	}
+	if tz := values.Get("tz"); tz != "" {
+		if query.Location, err = time.LoadLocation(tz); err != nil {
+			return query, apperror.Newf(apperror.CodeInvalidQuery, "Unknown time zone %q", tz)
+		}
+	}
+	query.Cursor = values.Get("cursor")
+
+	timeParams := []struct {
+		name string
+		dst  **time.Time
+	}{
+		{"created_after", &query.CreatedAfter},
+		{"created_before", &query.CreatedBefore},
+		{"updated_after", &query.UpdatedAfter},
+		{"updated_before", &query.UpdatedBefore},
+	}
+	for _, p := range timeParams {
+		if *p.dst, err = parseTimeParam(values, p.name); err != nil {
+			return query, err
+		}
+	}
+
+	for _, term := range splitList(values.Get("sort")) {
+		field := dto.SortField{Field: strings.TrimPrefix(term, "-"), Desc: strings.HasPrefix(term, "-")}
+		query.Sort = append(query.Sort, field)
+	}
+
+	if raw := values.Get("limit"); raw != "" {
+		query.Limit, err = strconv.Atoi(raw)
+		if err != nil || query.Limit < 1 || query.Limit > service.MaxListLimit {
+			return query, apperror.Newf(apperror.CodeInvalidQuery, "limit must be between 1 and %d", service.MaxListLimit)
+		}
+	}
+
+	return query, nil
+}
+
+// parseTimeParam accepts either an RFC 3339 timestamp or a plain date (YYYY-MM-DD).
+func parseTimeParam(values url.Values, name string) (*time.Time, error) {
+	raw := values.Get(name)
+	if raw == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return &t, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, raw, time.Local); err == nil {
+		return &t, nil
+	}
+	return nil, apperror.Newf(apperror.CodeInvalidQuery, "%s must be an RFC 3339 timestamp or a YYYY-MM-DD date", name)
+}
+
+// splitList splits a comma separated query parameter and drops empty items.
+func splitList(raw string) []string {
+	var items []string
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// setNextLink sets the Link header pointing to the next page of the current request.
+func setNextLink(w http.ResponseWriter, r *http.Request, cursor string) {
+	if cursor == "" {
+		return
+	}
+	next := *r.URL
+	values := next.Query()
+	values.Set("cursor", cursor)
+	next.RawQuery = values.Encode()
+	w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", next.String()))
+}
@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi/v5"
+	"net/http"
+	"strconv"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/service"
+	response "todo-api-golang/middleware"
+	"todo-api-golang/middleware/auth"
+)
+
+type TagHandlerInterface interface {
+	CreateTag(w http.ResponseWriter, r *http.Request)
+	ListTags(w http.ResponseWriter, r *http.Request)
+	RenameTag(w http.ResponseWriter, r *http.Request)
+	DeleteTag(w http.ResponseWriter, r *http.Request)
+	MergeTag(w http.ResponseWriter, r *http.Request)
+}
+
+type TagHandler struct {
+	service service.TagService
+}
+
+// NewTagHandler creates a new TagHandler.
+func NewTagHandler(service service.TagService) TagHandlerInterface {
+	return &TagHandler{service: service}
+}
+
+// CreateTag godoc
+// @Summary Create a new Tag
+// @Description Create a new Tag. Names are stored in lower case and must be unique per User.
+// @Tags tags
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param tag body dto.TagForm true "Tag form"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/tags [post]
+func (h *TagHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	var form dto.TagForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	tagDTO, err := h.service.CreateTag(r.Context(), userID, form)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusCreated, 201, "Tag created successfully", tagDTO)
+}
+
+// ListTags godoc
+// @Summary List all Tags
+// @Description Get the Tags of the authenticated User ordered by name, with the number of Todos using each Tag
+// @Tags tags
+// @Security BearerAuth
+// @Produce  json
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/tags [get]
+func (h *TagHandler) ListTags(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	tags, err := h.service.ListTags(r.Context(), userID)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Tags fetched successfully", tags)
+}
+
+// RenameTag godoc
+// @Summary Rename a Tag
+// @Description Rename a Tag by its ID. The Tag stays attached to its Todos.
+// @Tags tags
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Tag ID"
+// @Param tag body dto.TagForm true "Tag form"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/tags/{id} [put]
+func (h *TagHandler) RenameTag(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid tag ID"))
+		return
+	}
+
+	var form dto.TagForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	tagDTO, err := h.service.RenameTag(r.Context(), userID, id, form)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Tag renamed successfully", tagDTO)
+}
+
+// DeleteTag godoc
+// @Summary Delete a Tag by ID
+// @Description Delete a Tag and remove it from all of its Todos
+// @Tags tags
+// @Security BearerAuth
+// @Param id path int true "Tag ID"
+// @Success 204 {object} nil "No content"
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/tags/{id} [delete]
+func (h *TagHandler) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid tag ID"))
+		return
+	}
+
+	err = h.service.DeleteTag(r.Context(), userID, id)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusNoContent, 204, "Tag deleted successfully", nil)
+}
+
+// MergeTag godoc
+// @Summary Merge a Tag into another Tag
+// @Description Attach the target Tag to every Todo of the Tag and delete the Tag
+// @Tags tags
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Tag ID to merge and delete"
+// @Param merge body dto.MergeTagForm true "Target Tag"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/tags/{id}/merge [post]
+func (h *TagHandler) MergeTag(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid tag ID"))
+		return
+	}
+
+	var form dto.MergeTagForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	tagDTO, err := h.service.MergeTags(r.Context(), userID, id, form.IntoID)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Tags merged successfully", tagDTO)
+}
@@ -1,14 +1,16 @@
 package handlers
 
 import (
-	"context"
+	"encoding/json"
 	"github.com/go-chi/chi/v5"
 	"net/http"
 	"strconv"
-	"todo-api-golang/ent"
+	"todo-api-golang/internal/apperror"
 	"todo-api-golang/internal/dto"
 	"todo-api-golang/internal/service"
+	"todo-api-golang/internal/todoio"
 	response "todo-api-golang/middleware"
+	"todo-api-golang/middleware/auth"
 )
 
 type TodoHandlerInterface interface {
@@ -16,160 +18,347 @@ type TodoHandlerInterface interface {
 	ListTodos(w http.ResponseWriter, r *http.Request)
 	GetTodo(w http.ResponseWriter, r *http.Request)
 	UpdateTodo(w http.ResponseWriter, r *http.Request)
+	PatchTodo(w http.ResponseWriter, r *http.Request)
 	DeleteTodo(w http.ResponseWriter, r *http.Request)
 	UpdateTodoStatus(w http.ResponseWriter, r *http.Request)
+	ListTodoTransitions(w http.ResponseWriter, r *http.Request)
+	ListTodoHistory(w http.ResponseWriter, r *http.Request)
+	RevertTodo(w http.ResponseWriter, r *http.Request)
+	GetWorkflow(w http.ResponseWriter, r *http.Request)
+	MoveTodo(w http.ResponseWriter, r *http.Request)
+	CreateSubtask(w http.ResponseWriter, r *http.Request)
+	ListSubtasks(w http.ResponseWriter, r *http.Request)
+	SetTodoParent(w http.ResponseWriter, r *http.Request)
+	ListTrash(w http.ResponseWriter, r *http.Request)
+	RestoreTodo(w http.ResponseWriter, r *http.Request)
+	PurgeTodo(w http.ResponseWriter, r *http.Request)
+	BulkUpdateTodos(w http.ResponseWriter, r *http.Request)
+	ExportTodos(w http.ResponseWriter, r *http.Request)
+	ImportTodos(w http.ResponseWriter, r *http.Request)
 }
 
 type TodoHandler struct {
-	service service.TodoService
+	service  service.TodoService
+	projects service.ProjectService
 }
 
 // NewTodoHandler creates a new TodoHandler.
-func NewTodoHandler(service service.TodoService) TodoHandlerInterface {
-	return &TodoHandler{service: service}
+// The projects are used by the export and import formats that refer to projects by name.
+func NewTodoHandler(service service.TodoService, projects service.ProjectService) TodoHandlerInterface {
+	return &TodoHandler{service: service, projects: projects}
 }
 
 // CreateTodo godoc
 // @Summary Create a new Todo
-// @Description Create a new Todo with the given details
+// @Description Create a new Todo with the given details. Requests sent again with the same Idempotency-Key replay the first response instead of creating another Todo; reusing the key with a different body fails with 422 IDEMPOTENCY_KEY_REUSED.
 // @Tags todos
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
+// @Param Idempotency-Key header string false "Key identifying the request across retries"
 // @Param todo body dto.TodoForm true "Todo form"
 // @Success 200 {object} response.Response
 // @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
 // @Failure 500 {object} response.Response
 // @Router /api/v1/todos [post]
 func (h *TodoHandler) CreateTodo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
 	var form dto.TodoForm
-	httpCode, errCode := response.BindAndValid(r, &form)
-	if httpCode != http.StatusOK {
-		response.ResponseJSON(w, httpCode, errCode, "Invalid form data", nil)
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
 		return
 	}
 
-	todoDTO, err := h.service.CreateTodo(context.Background(), form)
+	todoDTO, err := h.service.CreateTodo(r.Context(), userID, form)
 	if err != nil {
-		response.ResponseJSON(w, http.StatusInternalServerError, 500, "Failed to create todo", nil)
+		response.ResponseError(w, err)
 		return
 	}
 
+	setTodoETag(w, todoDTO.Version)
 	response.ResponseJSON(w, http.StatusOK, 200, "Todo created successfully", todoDTO)
 }
 
 // ListTodos godoc
 // @Summary List all Todos
-// @Description Get a list of all Todos
+// @Description Get a page of the Todos owned by the authenticated User.
+// @Description The next page is advertised through the Link header (rel="next").
 // @Tags todos
+// @Security BearerAuth
 // @Produce  json
+// @Param status query string false "Comma separated statuses, e.g. PENDING,PROGRESS"
+// @Param q query string false "Free-text search in title and description"
+// @Param tag query string false "Comma separated tag names"
+// @Param tag_mode query string false "any (default) matches todos with at least one of the tags, all matches todos with every tag"
+// @Param project query string false "Project ID, or none for the todos without a project"
+// @Param include_archived query bool false "Include the todos of archived projects"
+// @Param top_level query bool false "Only the todos that are not a subtask"
+// @Param due query string false "Due window: overdue, today or week"
+// @Param tz query string false "IANA time zone used for the due window, e.g. Asia/Seoul (default server time zone)"
+// @Param created_after query string false "RFC 3339 timestamp or YYYY-MM-DD"
+// @Param created_before query string false "RFC 3339 timestamp or YYYY-MM-DD"
+// @Param updated_after query string false "RFC 3339 timestamp or YYYY-MM-DD"
+// @Param updated_before query string false "RFC 3339 timestamp or YYYY-MM-DD"
+// @Param sort query string false "Comma separated fields (id, title, priority, position, created_at, updated_at, due_at), prefix with - for descending, e.g. -created_at,title"
+// @Param cursor query string false "Opaque cursor taken from the Link header"
+// @Param limit query int false "Page size (1-100, default 20)"
 // @Success 200 {array} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
 // @Failure 500 {object} response.Response
 // @Router /api/v1/todos [get]
 func (h *TodoHandler) ListTodos(w http.ResponseWriter, r *http.Request) {
-	todoDTOs, err := h.service.ListTodos(context.Background())
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	query, err := parseTodoListQuery(r.URL.Query())
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	page, err := h.service.ListTodos(r.Context(), userID, query)
 	if err != nil {
-		response.ResponseJSON(w, http.StatusInternalServerError, 500, "Failed to list todos", nil)
+		response.ResponseError(w, err)
 		return
 	}
 
-	response.ResponseJSON(w, http.StatusOK, 200, "Todos fetched successfully", todoDTOs)
+	setNextLink(w, r, page.NextCursor)
+	response.ResponseJSON(w, http.StatusOK, 200, "Todos fetched successfully", page.Todos)
 }
 
 // GetTodo godoc
 // @Summary Get a Todo by ID
-// @Description Get details of a Todo by its ID
+// @Description Get details of a Todo by its ID. The response carries the version of the Todo as its ETag; with a matching If-None-Match header the response is 304 Not Modified.
 // @Tags todos
+// @Security BearerAuth
 // @Produce  json
 // @Param id path int true "Todo ID"
+// @Param If-None-Match header string false "ETag of a previously fetched version"
 // @Success 200 {object} response.Response
+// @Success 304 "Not modified"
 // @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
 // @Failure 404 {object} response.Response
 // @Failure 500 {object} response.Response
 // @Router /api/v1/todos/{id} [get]
 func (h *TodoHandler) GetTodo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
 	id, err := strconv.Atoi(chi.URLParam(r, "id"))
 	if err != nil {
-		response.ResponseJSON(w, http.StatusBadRequest, 400, "Invalid todo ID", nil)
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid todo ID"))
 		return
 	}
 
-	todoDTO, err := h.service.GetTodoByID(context.Background(), id)
+	todoDTO, err := h.service.GetTodoByID(r.Context(), userID, id)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			response.ResponseJSON(w, http.StatusNotFound, 404, "Todo not found", nil)
-		} else {
-			response.ResponseJSON(w, http.StatusInternalServerError, 500, "Failed to fetch todo", nil)
-		}
+		response.ResponseError(w, err)
 		return
 	}
 
+	setTodoETag(w, todoDTO.Version)
+	if ifNoneMatch(r, todoETag(todoDTO.Version)) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
 	response.ResponseJSON(w, http.StatusOK, 200, "Todo fetched successfully", todoDTO)
 }
 
 // UpdateTodo godoc
 // @Summary Update an existing Todo
-// @Description Update the details of a Todo by its ID
+// @Description Update the details of a Todo by its ID. With an If-Match header the update is only applied when the Todo is still at that ETag.
 // @Tags todos
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param id path int true "Todo ID"
+// @Param If-Match header string false "ETag of the version being edited"
 // @Param todo body dto.TodoForm true "Todo form"
 // @Success 200 {object} response.Response
 // @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
 // @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 412 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
 // @Failure 500 {object} response.Response
 // @Router /api/v1/todos/{id} [put]
 func (h *TodoHandler) UpdateTodo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
 	id, err := strconv.Atoi(chi.URLParam(r, "id"))
 	if err != nil {
-		response.ResponseJSON(w, http.StatusBadRequest, 400, "Invalid todo ID", nil)
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid todo ID"))
+		return
+	}
+
+	version, err := parseIfMatch(r)
+	if err != nil {
+		response.ResponseError(w, err)
 		return
 	}
 
 	var form dto.TodoForm
-	httpCode, errCode := response.BindAndValid(r, &form)
-	if httpCode != http.StatusOK {
-		response.ResponseJSON(w, httpCode, errCode, "Invalid form data", nil)
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
 		return
 	}
 
-	todoDTO, err := h.service.UpdateTodo(context.Background(), id, form)
+	todoDTO, err := h.service.UpdateTodo(r.Context(), userID, id, form, version)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			response.ResponseJSON(w, http.StatusNotFound, 404, "Todo not found", nil)
-		} else {
-			response.ResponseJSON(w, http.StatusInternalServerError, 500, "Failed to update todo", nil)
-		}
+		response.ResponseError(w, err)
 		return
 	}
 
+	setTodoETag(w, todoDTO.Version)
+	response.ResponseJSON(w, http.StatusOK, 200, "Todo updated successfully", todoDTO)
+}
+
+// PatchTodo godoc
+// @Summary Partially update a Todo
+// @Description Update only the supplied fields of a Todo. The body is either a JSON Merge Patch (application/merge-patch+json) or a JSON Patch (application/json-patch+json) applied to the fields of dto.TodoForm, and the result is validated like a full update. With an If-Match header the patch is only applied when the Todo is still at that ETag; a Todo changed while the patch is applied also fails with 412.
+// @Tags todos
+// @Security BearerAuth
+// @Accept  application/merge-patch+json
+// @Accept  application/json-patch+json
+// @Produce  json
+// @Param id path int true "Todo ID"
+// @Param If-Match header string false "ETag of the version being edited"
+// @Param patch body object true "Merge patch object or JSON Patch operations"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 412 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/{id} [patch]
+func (h *TodoHandler) PatchTodo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid todo ID"))
+		return
+	}
+
+	version, err := parseIfMatch(r)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	mediaType, patch, err := response.ReadBody(r, mediaTypeMergePatch, mediaTypeJSONPatch)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	current, err := h.service.GetTodoByID(r.Context(), userID, id)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+	if version != nil && *version != current.Version {
+		response.ResponseError(w, apperror.New(apperror.CodePreconditionFailed, "The todo was changed by another request"))
+		return
+	}
+
+	// 현재 상태의 폼에 패치를 적용하므로 패치에 없는 필드는 그대로 유지됩니다.
+	document, err := json.Marshal(dto.ConvertTodoToForm(*current))
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+	patched, err := applyPatch(mediaType, document, patch)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+	var form dto.TodoForm
+	if err := response.DecodeAndValid(patched, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	todoDTO, err := h.service.UpdateTodo(r.Context(), userID, id, form, &current.Version)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	setTodoETag(w, todoDTO.Version)
 	response.ResponseJSON(w, http.StatusOK, 200, "Todo updated successfully", todoDTO)
 }
 
 // DeleteTodo godoc
 // @Summary Soft delete a Todo by ID
-// @Description Soft delete a Todo by setting the deleted_at field
+// @Description Soft delete a Todo by setting the deleted_at field. With an If-Match header the Todo is only deleted when it is still at that ETag.
 // @Tags todos
+// @Security BearerAuth
 // @Param id path int true "Todo ID"
+// @Param If-Match header string false "ETag of the version being deleted"
 // @Success 204 {object} nil "No content"
 // @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
 // @Failure 404 {object} response.Response
+// @Failure 412 {object} response.Response
 // @Failure 500 {object} response.Response
 // @Router /api/v1/todos/{id} [delete]
 func (h *TodoHandler) DeleteTodo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
 	id, err := strconv.Atoi(chi.URLParam(r, "id"))
 	if err != nil {
-		response.ResponseJSON(w, http.StatusBadRequest, 400, "Invalid todo ID", nil)
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid todo ID"))
 		return
 	}
 
-	err = h.service.DeleteTodo(context.Background(), id)
+	version, err := parseIfMatch(r)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			response.ResponseJSON(w, http.StatusNotFound, 404, "Todo not found", nil)
-		} else {
-			response.ResponseJSON(w, http.StatusInternalServerError, 500, "Failed to delete todo", nil)
-		}
+		response.ResponseError(w, err)
+		return
+	}
+
+	err = h.service.DeleteTodo(r.Context(), userID, id, version)
+	if err != nil {
+		response.ResponseError(w, err)
 		return
 	}
 
@@ -178,40 +367,649 @@ func (h *TodoHandler) DeleteTodo(w http.ResponseWriter, r *http.Request) {
 
 // UpdateTodoStatus godoc
 // @Summary Update the status of a Todo
-// @Description Update only the status field of a Todo by its ID
+// @Description Move a Todo to another state of the workflow. Changes the workflow does not allow fail with 409 INVALID_TRANSITION. With an If-Match header the status is only changed when the Todo is still at that ETag.
 // @Tags todos
+// @Security BearerAuth
 // @Accept  json
 // @Produce  json
 // @Param id path int true "Todo ID"
+// @Param If-Match header string false "ETag of the version being edited"
 // @Param status body dto.UpdateStatusForm true "New status"
 // @Success 200 {object} response.Response
 // @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
 // @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 412 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
 // @Failure 500 {object} response.Response
 // @Router /api/v1/todos/{id}/status [put]
 func (h *TodoHandler) UpdateTodoStatus(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
 	id, err := strconv.Atoi(chi.URLParam(r, "id"))
 	if err != nil {
-		response.ResponseJSON(w, http.StatusBadRequest, 400, "Invalid todo ID", nil)
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid todo ID"))
+		return
+	}
+
+	version, err := parseIfMatch(r)
+	if err != nil {
+		response.ResponseError(w, err)
 		return
 	}
 
 	var form dto.UpdateStatusForm
-	httpCode, errCode := response.BindAndValid(r, &form)
-	if httpCode != http.StatusOK {
-		response.ResponseJSON(w, httpCode, errCode, "Invalid form data", nil)
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
 		return
 	}
 
-	todoDTO, err := h.service.UpdateTodoStatus(context.Background(), id, form.Status)
+	todoDTO, err := h.service.UpdateTodoStatus(r.Context(), userID, id, form.Status, version)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			response.ResponseJSON(w, http.StatusNotFound, 404, "Todo not found", nil)
-		} else {
-			response.ResponseJSON(w, http.StatusInternalServerError, 500, "Failed to update status", nil)
-		}
+		response.ResponseError(w, err)
 		return
 	}
 
+	setTodoETag(w, todoDTO.Version)
 	response.ResponseJSON(w, http.StatusOK, 200, "Status updated successfully", todoDTO)
 }
+
+// BulkUpdateTodos godoc
+// @Summary Apply an action to many Todos
+// @Description Change the status, delete, restore, move to a project, or add or remove a tag of many Todos in a single transaction. The Todos are given by "ids" or selected by "filter"; restore selects from the trash. The result reports the outcome per Todo. Todos the action fails for are skipped, unless "all_or_nothing" is set, in which case nothing is changed and 422 is returned with the results. At most BULK_MAX_ITEMS Todos can be changed at once.
+// @Tags todos
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param Idempotency-Key header string false "Key identifying the request across retries"
+// @Param bulk body dto.BulkTodoForm true "Bulk action"
+// @Success 200 {object} response.Response{data=dto.BulkResultDTO}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response{data=dto.BulkResultDTO}
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/bulk [post]
+func (h *TodoHandler) BulkUpdateTodos(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	var form dto.BulkTodoForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	result, err := h.service.BulkUpdateTodos(r.Context(), userID, form)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	if !result.Committed {
+		response.ResponseJSON(w, http.StatusUnprocessableEntity, 422, "Bulk action rolled back", result)
+		return
+	}
+	response.ResponseJSON(w, http.StatusOK, 200, "Bulk action applied", result)
+}
+
+// ListTodoTransitions godoc
+// @Summary List the status history of a Todo
+// @Description List every status change of a Todo, oldest first. The first entry has no "from" status.
+// @Tags todos
+// @Security BearerAuth
+// @Produce  json
+// @Param id path int true "Todo ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/{id}/transitions [get]
+func (h *TodoHandler) ListTodoTransitions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid todo ID"))
+		return
+	}
+
+	transitionDTOs, err := h.service.ListTodoTransitions(r.Context(), userID, id)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Transitions retrieved successfully", transitionDTOs)
+}
+
+// ListTodoHistory godoc
+// @Summary List the revision history of a Todo
+// @Description List every recorded change of a Todo, oldest first, with the old and new value of each changed field
+// @Tags todos
+// @Security BearerAuth
+// @Produce  json
+// @Param id path int true "Todo ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/{id}/history [get]
+func (h *TodoHandler) ListTodoHistory(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid todo ID"))
+		return
+	}
+
+	revisionDTOs, err := h.service.ListTodoHistory(r.Context(), userID, id)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "History retrieved successfully", revisionDTOs)
+}
+
+// RevertTodo godoc
+// @Summary Revert a Todo to a revision
+// @Description Restore the title, description, status, priority, project, tags, dates and auto_complete of a Todo to the state recorded by a revision. The revert is itself recorded as a new revision.
+// @Tags todos
+// @Security BearerAuth
+// @Produce  json
+// @Param id path int true "Todo ID"
+// @Param revisionID path int true "Revision ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/{id}/history/{revisionID}/revert [post]
+func (h *TodoHandler) RevertTodo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid todo ID"))
+		return
+	}
+	revisionID, err := strconv.Atoi(chi.URLParam(r, "revisionID"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid revision ID"))
+		return
+	}
+
+	todoDTO, err := h.service.RevertTodo(r.Context(), userID, id, revisionID)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	setTodoETag(w, todoDTO.Version)
+	response.ResponseJSON(w, http.StatusOK, 200, "Todo reverted successfully", todoDTO)
+}
+
+// GetWorkflow godoc
+// @Summary Get the Todo workflow
+// @Description Get the states a Todo can be in and the states each of them may move to
+// @Tags todos
+// @Security BearerAuth
+// @Produce  json
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /api/v1/todos/workflow [get]
+func (h *TodoHandler) GetWorkflow(w http.ResponseWriter, r *http.Request) {
+	response.ResponseJSON(w, http.StatusOK, 200, "Workflow retrieved successfully", h.service.GetWorkflow())
+}
+
+// MoveTodo godoc
+// @Summary Move a Todo
+// @Description Place a Todo directly before or after another Todo in the manual order. Only the moved Todo is updated.
+// @Tags todos
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Todo ID"
+// @Param move body dto.MoveTodoForm true "Exactly one of before_id and after_id"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/{id}/move [put]
+func (h *TodoHandler) MoveTodo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid todo ID"))
+		return
+	}
+
+	var form dto.MoveTodoForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	todoDTO, err := h.service.MoveTodo(r.Context(), userID, id, form)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	setTodoETag(w, todoDTO.Version)
+	response.ResponseJSON(w, http.StatusOK, 200, "Todo moved successfully", todoDTO)
+}
+
+// CreateSubtask godoc
+// @Summary Create a subtask
+// @Description Create a Todo under the given Todo. The subtask inherits the parent's project unless project_id is set.
+// @Tags todos
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Parent Todo ID"
+// @Param todo body dto.TodoForm true "Todo form"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/{id}/subtasks [post]
+func (h *TodoHandler) CreateSubtask(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid todo ID"))
+		return
+	}
+
+	var form dto.TodoForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	todoDTO, err := h.service.CreateSubtask(r.Context(), userID, id, form)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	setTodoETag(w, todoDTO.Version)
+	response.ResponseJSON(w, http.StatusCreated, 201, "Subtask created successfully", todoDTO)
+}
+
+// ListSubtasks godoc
+// @Summary List the subtasks of a Todo
+// @Description Get the direct subtasks of a Todo in their manual order
+// @Tags todos
+// @Security BearerAuth
+// @Produce  json
+// @Param id path int true "Parent Todo ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/{id}/subtasks [get]
+func (h *TodoHandler) ListSubtasks(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid todo ID"))
+		return
+	}
+
+	todos, err := h.service.ListSubtasks(r.Context(), userID, id)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Subtasks fetched successfully", todos)
+}
+
+// SetTodoParent godoc
+// @Summary Change the parent of a Todo
+// @Description Move a Todo under another Todo, or to the top level with a null parent_id
+// @Tags todos
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Todo ID"
+// @Param parent body dto.SetParentForm true "New parent"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/{id}/parent [put]
+func (h *TodoHandler) SetTodoParent(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid todo ID"))
+		return
+	}
+
+	var form dto.SetParentForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	todoDTO, err := h.service.SetTodoParent(r.Context(), userID, id, form.ParentID)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	setTodoETag(w, todoDTO.Version)
+	response.ResponseJSON(w, http.StatusOK, 200, "Parent updated successfully", todoDTO)
+}
+
+// ListTrash godoc
+// @Summary List soft-deleted Todos
+// @Description Get a page of the soft-deleted Todos owned by the authenticated User.
+// @Description Accepts the same filter, sort and pagination parameters as GET /api/v1/todos.
+// @Tags todos
+// @Security BearerAuth
+// @Produce  json
+// @Param status query string false "Comma separated statuses, e.g. PENDING,PROGRESS"
+// @Param q query string false "Free-text search in title and description"
+// @Param sort query string false "Comma separated fields, prefix with - for descending, e.g. -updated_at"
+// @Param cursor query string false "Opaque cursor taken from the Link header"
+// @Param limit query int false "Page size (1-100, default 20)"
+// @Success 200 {array} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/trash [get]
+func (h *TodoHandler) ListTrash(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	query, err := parseTodoListQuery(r.URL.Query())
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	page, err := h.service.ListTrash(r.Context(), userID, query)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	setNextLink(w, r, page.NextCursor)
+	response.ResponseJSON(w, http.StatusOK, 200, "Trash fetched successfully", page.Todos)
+}
+
+// RestoreTodo godoc
+// @Summary Restore a soft-deleted Todo
+// @Description Move a Todo out of the trash by clearing its deleted_at field
+// @Tags todos
+// @Security BearerAuth
+// @Produce  json
+// @Param id path int true "Todo ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/{id}/restore [put]
+func (h *TodoHandler) RestoreTodo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid todo ID"))
+		return
+	}
+
+	todoDTO, err := h.service.RestoreTodo(r.Context(), userID, id)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	setTodoETag(w, todoDTO.Version)
+	response.ResponseJSON(w, http.StatusOK, 200, "Todo restored successfully", todoDTO)
+}
+
+// PurgeTodo godoc
+// @Summary Permanently delete a Todo
+// @Description Permanently delete a Todo that is in the trash
+// @Tags todos
+// @Security BearerAuth
+// @Param id path int true "Todo ID"
+// @Success 204 {object} nil "No content"
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/trash/{id} [delete]
+func (h *TodoHandler) PurgeTodo(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid todo ID"))
+		return
+	}
+
+	err = h.service.PurgeTodo(r.Context(), userID, id)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusNoContent, 204, "Todo permanently deleted", nil)
+}
+
+// ExportTodos godoc
+// @Summary Export Todos
+// @Description Download every Todo of the User matching the filters of GET /api/v1/todos as CSV, newline-delimited JSON, a Markdown checklist, iCalendar or todo.txt. The export is streamed in the order given by sort; cursor and limit are ignored. CSV files have a header row and join tags with commas; in Markdown the fields without Markdown syntax are kept in an HTML comment after the title. iCalendar files have a VTODO per Todo carrying its UID. todo.txt files have a line per Todo with its priority (A for URGENT to D for LOW), completion mark, creation and completion dates, +project and a @context per tag; descriptions are left out.
+// @Tags todos
+// @Security BearerAuth
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Produce text/markdown
+// @Produce text/calendar
+// @Produce text/plain
+// @Param format query string false "csv (default), ndjson, markdown, ics or todotxt"
+// @Param status query string false "Comma separated statuses, e.g. PENDING,PROGRESS"
+// @Param q query string false "Free-text search in title and description"
+// @Param tag query string false "Comma separated tag names"
+// @Param tag_mode query string false "any (default) matches todos with at least one of the tags, all matches todos with every tag"
+// @Param project query string false "Project ID, or none for the todos without a project"
+// @Param include_archived query bool false "Include the todos of archived projects"
+// @Param top_level query bool false "Only the todos that are not a subtask"
+// @Param due query string false "Due window: overdue, today or week"
+// @Param tz query string false "IANA time zone used for the due window, e.g. Asia/Seoul (default server time zone)"
+// @Param created_after query string false "RFC 3339 timestamp or YYYY-MM-DD"
+// @Param created_before query string false "RFC 3339 timestamp or YYYY-MM-DD"
+// @Param updated_after query string false "RFC 3339 timestamp or YYYY-MM-DD"
+// @Param updated_before query string false "RFC 3339 timestamp or YYYY-MM-DD"
+// @Param sort query string false "Comma separated fields (id, title, priority, position, created_at, updated_at, due_at), prefix with - for descending, e.g. -created_at,title"
+// @Success 200 {file} file
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/export [get]
+func (h *TodoHandler) ExportTodos(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = dto.FormatCSV
+	}
+	query, err := parseTodoListQuery(r.URL.Query())
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+	options, err := formatOptions(r.Context(), h.service, h.projects, userID)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+	writeExport(w, r, h.service, userID, format, query, options)
+}
+
+// ImportTodos godoc
+// @Summary Import Todos
+// @Description Create Todos from a CSV, newline-delimited JSON, Markdown checklist, iCalendar or todo.txt (text/plain) file in the formats of GET /api/v1/todos/export; the format is given by the Content-Type of the body. Every row is validated like the body of POST /api/v1/todos and the result reports the outcome per row together with its line in the file. CSV columns are matched by name and columns that are not fields of the form are ignored, as are the fields of the REST representation in NDJSON. Markdown items without a status get the first state of the workflow, or its complete state when checked.
+// @Description In iCalendar files every VTODO is a row. A VTODO with the UID of a Todo of the User updates that Todo instead of creating another one, so importing an export again does not duplicate Todos; a UID of a Todo in the trash fails with CONFLICT. VTODOs from other apps get the first state of the workflow for NEEDS-ACTION, the first started state for IN-PROCESS and the complete state for COMPLETED.
+// @Description In todo.txt files every non-blank line is a row. Tasks marked x get the complete state of the workflow and other tasks its first state, unless they have a status: tag; +project is matched with the names of the projects of the User and @contexts become tags. Projects without a match and key:value tags other than status, due, remind and auto_complete are kept in the description.
+// @Description The import is a single transaction: unless every row succeeds nothing is changed and 422 is returned with the results. With dry_run=true the rows are checked without changing anything. At most 1000 Todos can be imported at once.
+// @Tags todos
+// @Security BearerAuth
+// @Accept text/csv
+// @Accept application/x-ndjson
+// @Accept text/markdown
+// @Accept text/calendar
+// @Accept text/plain
+// @Produce  json
+// @Param Idempotency-Key header string false "Key identifying the request across retries"
+// @Param dry_run query bool false "Only check the rows"
+// @Param file body string true "Contents of the file"
+// @Success 200 {object} response.Response{data=dto.ImportResultDTO}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response{data=dto.ImportResultDTO}
+// @Failure 500 {object} response.Response
+// @Router /api/v1/todos/import [post]
+func (h *TodoHandler) ImportTodos(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	var dryRun bool
+	if raw := r.URL.Query().Get("dry_run"); raw != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(raw); err != nil {
+			response.ResponseError(w, apperror.New(apperror.CodeInvalidQuery, "dry_run must be a boolean"))
+			return
+		}
+	}
+
+	mediaType, body, err := response.ReadBody(r, todoio.MediaTypes()...)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+	format, _ := todoio.FormatOf(mediaType)
+	options, err := formatOptions(r.Context(), h.service, h.projects, userID)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+	rows, err := todoio.Decode(format, body, options)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+	for i := range rows {
+		if rows[i].Err == nil {
+			rows[i].Err = response.Validate(&rows[i].Form)
+		}
+	}
+
+	result, err := h.service.ImportTodos(r.Context(), userID, rows, dryRun)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	switch {
+	case result.Failed > 0:
+		response.ResponseJSON(w, http.StatusUnprocessableEntity, 422, "Import rolled back", result)
+	case result.DryRun:
+		response.ResponseJSON(w, http.StatusOK, 200, "Import checked", result)
+	default:
+		response.ResponseJSON(w, http.StatusOK, 200, "Todos imported successfully", result)
+	}
+}
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"net/http"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/service"
+	response "todo-api-golang/middleware"
+	"todo-api-golang/middleware/auth"
+)
+
+type UserHandlerInterface interface {
+	Signup(w http.ResponseWriter, r *http.Request)
+	GetUser(w http.ResponseWriter, r *http.Request)
+	UpdateUser(w http.ResponseWriter, r *http.Request)
+	DeleteUser(w http.ResponseWriter, r *http.Request)
+}
+
+type UserHandler struct {
+	service service.UserService
+}
+
+// NewUserHandler creates a new UserHandler.
+func NewUserHandler(service service.UserService) UserHandlerInterface {
+	return &UserHandler{service: service}
+}
+
+// Signup godoc
+// @Summary Register a new User
+// @Description Create a new User account with the given email, display name and password
+// @Tags users
+// @Accept  json
+// @Produce  json
+// @Param user body dto.SignupForm true "Signup form"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/users [post]
+func (h *UserHandler) Signup(w http.ResponseWriter, r *http.Request) {
+	var form dto.SignupForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	userDTO, err := h.service.CreateUser(r.Context(), form)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusCreated, 201, "User created successfully", userDTO)
+}
+
+// GetUser godoc
+// @Summary Get the current User
+// @Description Get the profile of the authenticated User
+// @Tags users
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/users/me [get]
+func (h *UserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	userDTO, err := h.service.GetUserByID(r.Context(), id)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "User fetched successfully", userDTO)
+}
+
+// UpdateUser godoc
+// @Summary Update the current User
+// @Description Update the display name and optionally the password of the authenticated User. Changing the password requires current_password and revokes every refresh token of the User.
+// @Tags users
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param user body dto.UpdateUserForm true "User form"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/users/me [put]
+func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	var form dto.UpdateUserForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	userDTO, err := h.service.UpdateUser(r.Context(), id, form)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "User updated successfully", userDTO)
+}
+
+// DeleteUser godoc
+// @Summary Delete the current User
+// @Description Permanently delete the account of the authenticated User
+// @Tags users
+// @Security BearerAuth
+// @Success 204 {object} nil "No content"
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/users/me [delete]
+func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	err := h.service.DeleteUser(r.Context(), id)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusNoContent, 204, "User deleted successfully", nil)
+}
@@ -0,0 +1,285 @@
+package handlers
+
+import (
+	"github.com/go-chi/chi/v5"
+	"net/http"
+	"strconv"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/service"
+	response "todo-api-golang/middleware"
+	"todo-api-golang/middleware/auth"
+)
+
+type WebhookHandlerInterface interface {
+	CreateWebhook(w http.ResponseWriter, r *http.Request)
+	ListWebhooks(w http.ResponseWriter, r *http.Request)
+	GetWebhook(w http.ResponseWriter, r *http.Request)
+	UpdateWebhook(w http.ResponseWriter, r *http.Request)
+	DeleteWebhook(w http.ResponseWriter, r *http.Request)
+	ListDeliveries(w http.ResponseWriter, r *http.Request)
+	RedeliverDelivery(w http.ResponseWriter, r *http.Request)
+}
+
+type WebhookHandler struct {
+	service service.WebhookService
+}
+
+// NewWebhookHandler creates a new WebhookHandler.
+func NewWebhookHandler(service service.WebhookService) WebhookHandlerInterface {
+	return &WebhookHandler{service: service}
+}
+
+// CreateWebhook godoc
+// @Summary Register a new Webhook
+// @Description Register a URL that receives a signed POST for every change of the Todos of the User. Leave events empty to receive every event type. When no secret is given one is generated; the secret is only returned by this call and when it is changed. Each delivery carries the X-Webhook-Signature header "t=<unix time>,v1=<hex HMAC-SHA256 of "<unix time>.<body>">".
+// @Tags webhooks
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param webhook body dto.WebhookForm true "Webhook form"
+// @Success 201 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/webhooks [post]
+func (h *WebhookHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	var form dto.WebhookForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	webhookDTO, err := h.service.CreateWebhook(r.Context(), userID, form)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusCreated, 201, "Webhook created successfully", webhookDTO)
+}
+
+// ListWebhooks godoc
+// @Summary List all Webhooks
+// @Description Get the Webhooks registered by the authenticated User
+// @Tags webhooks
+// @Security BearerAuth
+// @Produce  json
+// @Success 200 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/webhooks [get]
+func (h *WebhookHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	webhooks, err := h.service.ListWebhooks(r.Context(), userID)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Webhooks fetched successfully", webhooks)
+}
+
+// GetWebhook godoc
+// @Summary Get a Webhook by ID
+// @Description Get details of a Webhook by its ID
+// @Tags webhooks
+// @Security BearerAuth
+// @Produce  json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/webhooks/{id} [get]
+func (h *WebhookHandler) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid webhook ID"))
+		return
+	}
+
+	webhookDTO, err := h.service.GetWebhook(r.Context(), userID, id)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Webhook fetched successfully", webhookDTO)
+}
+
+// UpdateWebhook godoc
+// @Summary Update an existing Webhook
+// @Description Replace the URL, event types and active flag of a Webhook. The secret is only changed when one is given.
+// @Tags webhooks
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Webhook ID"
+// @Param webhook body dto.WebhookForm true "Webhook form"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 413 {object} response.Response
+// @Failure 415 {object} response.Response
+// @Failure 422 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/webhooks/{id} [put]
+func (h *WebhookHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid webhook ID"))
+		return
+	}
+
+	var form dto.WebhookForm
+	if err := response.BindAndValid(r, &form); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	webhookDTO, err := h.service.UpdateWebhook(r.Context(), userID, id, form)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Webhook updated successfully", webhookDTO)
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a Webhook by ID
+// @Description Delete a Webhook together with its delivery log
+// @Tags webhooks
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Success 204 {object} nil "No content"
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid webhook ID"))
+		return
+	}
+
+	if err := h.service.DeleteWebhook(r.Context(), userID, id); err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusNoContent, 204, "Webhook deleted successfully", nil)
+}
+
+// ListDeliveries godoc
+// @Summary List the deliveries of a Webhook
+// @Description Get the latest 100 deliveries of a Webhook, newest first, with their status, attempts and last response
+// @Tags webhooks
+// @Security BearerAuth
+// @Produce  json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid webhook ID"))
+		return
+	}
+
+	deliveries, err := h.service.ListDeliveries(r.Context(), userID, id)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusOK, 200, "Deliveries fetched successfully", deliveries)
+}
+
+// RedeliverDelivery godoc
+// @Summary Redeliver a delivery of a Webhook
+// @Description Queue the payload of a delivery to be sent again. The redelivery is a new delivery with its own attempts; the original entry is kept.
+// @Tags webhooks
+// @Security BearerAuth
+// @Produce  json
+// @Param id path int true "Webhook ID"
+// @Param deliveryID path int true "Delivery ID"
+// @Success 202 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/webhooks/{id}/deliveries/{deliveryID}/redeliver [post]
+func (h *WebhookHandler) RedeliverDelivery(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Unauthorized"))
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid webhook ID"))
+		return
+	}
+	deliveryID, err := strconv.Atoi(chi.URLParam(r, "deliveryID"))
+	if err != nil {
+		response.ResponseError(w, apperror.New(apperror.CodeInvalidParameter, "Invalid delivery ID"))
+		return
+	}
+
+	delivery, err := h.service.RedeliverDelivery(r.Context(), userID, id, deliveryID)
+	if err != nil {
+		response.ResponseError(w, err)
+		return
+	}
+
+	response.ResponseJSON(w, http.StatusAccepted, 202, "Delivery queued successfully", delivery)
+}
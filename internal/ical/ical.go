@@ -0,0 +1,426 @@
+// Package ical reads and writes the content lines of iCalendar files (RFC 5545).
+//
+// It only knows the syntax of the format: components nested between BEGIN and END lines,
+// properties with parameters, line folding and the escaping of text values. What the
+// components and properties mean is up to the caller.
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineOctets is the longest a content line may be before it is folded, without the line break.
+const maxLineOctets = 75
+
+// Property is a content line of a component, e.g. DUE;TZID=Asia/Seoul:20260102T090000.
+// Names and parameter names are upper case; the value is kept as written, i.e. still escaped.
+type Property struct {
+	Name   string
+	Params map[string]string
+	Value  string
+	// Line is the line of the file the property starts on; it is 0 for properties being written.
+	Line int
+}
+
+// Param returns the value of a parameter, or "" when the property does not have it.
+func (p *Property) Param(name string) string {
+	return p.Params[name]
+}
+
+// Text returns the value of a TEXT property with the escapes undone.
+func (p *Property) Text() string {
+	return UnescapeText(p.Value)
+}
+
+// TextList returns the values of a property holding a comma separated list of TEXT, e.g. CATEGORIES.
+func (p *Property) TextList() []string {
+	var (
+		values []string
+		start  int
+	)
+	for i := 0; i < len(p.Value); i++ {
+		switch p.Value[i] {
+		case '\\':
+			i++
+		case ',':
+			values = append(values, UnescapeText(p.Value[start:i]))
+			start = i + 1
+		}
+	}
+	return append(values, UnescapeText(p.Value[start:]))
+}
+
+// Time returns the value of a DATE or DATE-TIME property. Times in UTC end with Z; other times
+// are in the zone named by the TZID parameter, or in loc when the zone is unknown or not given
+// ("floating" times). Dates are midnight in loc.
+func (p *Property) Time(loc *time.Location) (time.Time, error) {
+	if p.Param("VALUE") == "DATE" || len(p.Value) == len("20060102") {
+		return time.ParseInLocation("20060102", p.Value, loc)
+	}
+	if strings.HasSuffix(p.Value, "Z") {
+		return time.Parse("20060102T150405Z", p.Value)
+	}
+	if tzid := p.Param("TZID"); tzid != "" {
+		// VTIMEZONE 정의는 읽지 않으므로 IANA 이름이 아닌 TZID는 loc로 대신합니다.
+		if zone, err := time.LoadLocation(strings.TrimPrefix(tzid, "/")); err == nil {
+			loc = zone
+		}
+	}
+	return time.ParseInLocation("20060102T150405", p.Value, loc)
+}
+
+// Duration returns the value of a DURATION property, e.g. -PT15M or P1DT12H.
+func (p *Property) Duration() (time.Duration, error) {
+	return ParseDuration(p.Value)
+}
+
+// Component is a component of a file, e.g. VCALENDAR or VTODO, with its properties and the
+// components nested in it.
+type Component struct {
+	Name       string
+	Properties []Property
+	Components []*Component
+	// Line is the line of the BEGIN line of the component.
+	Line int
+}
+
+// Get returns the first property with the given name, or nil when the component does not have it.
+func (c *Component) Get(name string) *Property {
+	for i := range c.Properties {
+		if c.Properties[i].Name == name {
+			return &c.Properties[i]
+		}
+	}
+	return nil
+}
+
+// All returns every property with the given name, for properties that may occur more than once.
+func (c *Component) All(name string) []Property {
+	var properties []Property
+	for _, p := range c.Properties {
+		if p.Name == name {
+			properties = append(properties, p)
+		}
+	}
+	return properties
+}
+
+// Find returns the components with the given name nested at any depth, in the order of the file.
+func (c *Component) Find(name string) []*Component {
+	var found []*Component
+	for _, child := range c.Components {
+		if child.Name == name {
+			found = append(found, child)
+		}
+		found = append(found, child.Find(name)...)
+	}
+	return found
+}
+
+// ParseError is returned by Parse for a file that is not valid iCalendar.
+type ParseError struct {
+	Line int
+	Msg  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("ical: line %d: %s", e.Line, e.Msg)
+}
+
+// Parse reads the components of a file. The returned component is a root without a name
+// holding the top-level components, usually a single VCALENDAR. Lines may end in CRLF or LF.
+func Parse(data []byte) (*Component, error) {
+	root := &Component{}
+	stack := []*Component{root}
+	for _, line := range unfold(data) {
+		p, err := parseLine(line.text)
+		if err != nil {
+			return nil, &ParseError{Line: line.number, Msg: err.Error()}
+		}
+		p.Line = line.number
+		current := stack[len(stack)-1]
+		switch p.Name {
+		case "BEGIN":
+			child := &Component{Name: strings.ToUpper(p.Value), Line: line.number}
+			current.Components = append(current.Components, child)
+			stack = append(stack, child)
+		case "END":
+			if len(stack) == 1 || current.Name != strings.ToUpper(p.Value) {
+				return nil, &ParseError{Line: line.number, Msg: "unexpected END:" + p.Value}
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if len(stack) == 1 {
+				return nil, &ParseError{Line: line.number, Msg: "property " + p.Name + " outside of a component"}
+			}
+			current.Properties = append(current.Properties, p)
+		}
+	}
+	if len(stack) > 1 {
+		current := stack[len(stack)-1]
+		return nil, &ParseError{Line: current.Line, Msg: "BEGIN:" + current.Name + " without END"}
+	}
+	return root, nil
+}
+
+type contentLine struct {
+	number int
+	text   string
+}
+
+// unfold joins the lines continued by a leading space or tab with the line before them.
+func unfold(data []byte) []contentLine {
+	var lines []contentLine
+	for i, raw := range bytes.Split(data, []byte("\n")) {
+		text := strings.TrimSuffix(string(raw), "\r")
+		if (strings.HasPrefix(text, " ") || strings.HasPrefix(text, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1].text += text[1:]
+			continue
+		}
+		if text != "" {
+			lines = append(lines, contentLine{number: i + 1, text: text})
+		}
+	}
+	return lines
+}
+
+// parseLine splits a content line into its name, parameters and value:
+//
+//	name *(";" param-name "=" param-value *("," param-value)) ":" value
+func parseLine(line string) (Property, error) {
+	end := strings.IndexAny(line, ";:")
+	if end <= 0 {
+		return Property{}, fmt.Errorf("missing property name or value")
+	}
+	p := Property{Name: strings.ToUpper(line[:end])}
+	rest := line[end:]
+	for strings.HasPrefix(rest, ";") {
+		eq := strings.IndexByte(rest, '=')
+		if eq < 0 {
+			return Property{}, fmt.Errorf("parameter of %s without value", p.Name)
+		}
+		name := strings.ToUpper(rest[1:eq])
+		rest = rest[eq+1:]
+		// 따옴표로 감싼 값에는 ;와 :가 들어갈 수 있습니다.
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			closing := strings.IndexByte(rest[1:], '"')
+			if closing < 0 {
+				return Property{}, fmt.Errorf("unterminated quoted parameter of %s", p.Name)
+			}
+			value, rest = rest[1:closing+1], rest[closing+2:]
+		} else {
+			stop := strings.IndexAny(rest, ";:")
+			if stop < 0 {
+				return Property{}, fmt.Errorf("missing value of %s", p.Name)
+			}
+			value, rest = rest[:stop], rest[stop:]
+		}
+		if p.Params == nil {
+			p.Params = make(map[string]string)
+		}
+		p.Params[name] = value
+	}
+	if !strings.HasPrefix(rest, ":") {
+		return Property{}, fmt.Errorf("missing value of %s", p.Name)
+	}
+	p.Value = rest[1:]
+	return p, nil
+}
+
+// EscapeText escapes a TEXT value: backslashes, semicolons, commas and line breaks.
+func EscapeText(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\', ';', ',':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case '\r':
+			// CRLF는 \n 하나로 씁니다.
+			if i+1 < len(s) && s[i+1] == '\n' {
+				continue
+			}
+			b.WriteString(`\n`)
+		case '\n':
+			b.WriteString(`\n`)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// UnescapeText undoes EscapeText.
+func UnescapeText(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+// FormatTime writes a DATE-TIME value in UTC, e.g. 20260102T000000Z.
+func FormatTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// ParseDuration reads a DURATION value: an optional sign, P and then either weeks (P2W) or
+// days and a time part (P1DT2H30M15S).
+func ParseDuration(s string) (time.Duration, error) {
+	raw := s
+	sign := time.Duration(1)
+	switch {
+	case strings.HasPrefix(s, "-"):
+		sign, s = -1, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	if !strings.HasPrefix(s, "P") || len(s) == 1 {
+		return 0, fmt.Errorf("ical: invalid duration %q", raw)
+	}
+	s = s[1:]
+
+	var (
+		d      time.Duration
+		n      int64
+		digits bool
+		inTime bool
+	)
+	for _, c := range s {
+		if c >= '0' && c <= '9' {
+			n = n*10 + int64(c-'0')
+			digits = true
+			continue
+		}
+		if c == 'T' && !inTime && !digits {
+			inTime = true
+			continue
+		}
+		var unit time.Duration
+		switch {
+		case c == 'W' && !inTime:
+			unit = 7 * 24 * time.Hour
+		case c == 'D' && !inTime:
+			unit = 24 * time.Hour
+		case c == 'H' && inTime:
+			unit = time.Hour
+		case c == 'M' && inTime:
+			unit = time.Minute
+		case c == 'S' && inTime:
+			unit = time.Second
+		}
+		if unit == 0 || !digits {
+			return 0, fmt.Errorf("ical: invalid duration %q", raw)
+		}
+		d += time.Duration(n) * unit
+		n, digits = 0, false
+	}
+	if digits {
+		return 0, fmt.Errorf("ical: invalid duration %q", raw)
+	}
+	return sign * d, nil
+}
+
+// Writer writes the content lines of a file, folding lines longer than 75 octets.
+// The first error is kept and returned by every later call.
+type Writer struct {
+	w   io.Writer
+	err error
+}
+
+// NewWriter returns a Writer writing to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Begin starts a component.
+func (w *Writer) Begin(name string) error {
+	return w.Write(Property{Name: "BEGIN", Value: name})
+}
+
+// End ends a component.
+func (w *Writer) End(name string) error {
+	return w.Write(Property{Name: "END", Value: name})
+}
+
+// Text writes a TEXT property, escaping its value.
+func (w *Writer) Text(name, value string) error {
+	return w.Write(Property{Name: name, Value: EscapeText(value)})
+}
+
+// TextList writes a property holding a list of TEXT values.
+func (w *Writer) TextList(name string, values []string) error {
+	escaped := make([]string, len(values))
+	for i, value := range values {
+		escaped[i] = EscapeText(value)
+	}
+	return w.Write(Property{Name: name, Value: strings.Join(escaped, ",")})
+}
+
+// Time writes a DATE-TIME property in UTC.
+func (w *Writer) Time(name string, t time.Time) error {
+	return w.Write(Property{Name: name, Value: FormatTime(t)})
+}
+
+// Write writes a property as is; its value must already be escaped.
+func (w *Writer) Write(p Property) error {
+	if w.err != nil {
+		return w.err
+	}
+	var b strings.Builder
+	b.WriteString(p.Name)
+	names := make([]string, 0, len(p.Params))
+	for name := range p.Params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := p.Params[name]
+		if strings.ContainsAny(value, ";:,") {
+			value = `"` + value + `"`
+		}
+		b.WriteString(";" + name + "=" + value)
+	}
+	b.WriteString(":" + p.Value)
+	_, w.err = io.WriteString(w.w, fold(b.String()))
+	return w.err
+}
+
+// fold breaks a content line into lines of at most 75 octets, continued by a leading space,
+// without splitting UTF-8 sequences.
+func fold(line string) string {
+	var b strings.Builder
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		// 이어지는 줄의 공백도 75옥텟에 포함됩니다.
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line + "\r\n")
+	return b.String()
+}
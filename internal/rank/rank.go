@@ -0,0 +1,200 @@
+// Package rank generates lexicographic position keys for manually ordered lists.
+//
+// A key sorts between two others by plain string comparison, so an item can be moved
+// by rewriting only its own key instead of renumbering the whole list.
+//
+// A key is an integer part followed by an optional fraction. The first character of the
+// integer part encodes its length: "a".."z" are non-negative integers of 1..26 digits and
+// "9".."0" are negative integers of 1..10 digits. Appending to either end of a list
+// increments the integer part, so keys grow logarithmically instead of linearly;
+// only inserting between two neighbours extends the fraction.
+//
+// Keys use the digits 0-9 and the lower-case letters a-z only, which compare the same way
+// under binary and case-insensitive collations.
+package rank
+
+import "strings"
+
+const digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// Initial is the key given to the first item of an empty list.
+const Initial = "a0"
+
+// smallestInteger cannot be decremented, so it is never handed out as a key on its own.
+var smallestInteger = "0" + strings.Repeat("0", 10)
+
+// Between returns a key that sorts strictly after a and strictly before b.
+// An empty a means "before everything" and an empty b means "after everything".
+// It returns false when a does not sort before b or either key is malformed.
+func Between(a, b string) (string, bool) {
+	if a != "" && !valid(a) || b != "" && !valid(b) || a != "" && b != "" && a >= b {
+		return "", false
+	}
+
+	switch {
+	case a == "" && b == "":
+		return Initial, true
+	case a == "":
+		ib := integerPart(b)
+		fb := b[len(ib):]
+		if ib == smallestInteger {
+			return ib + midpoint("", fb), true
+		}
+		if fb != "" {
+			return ib, true
+		}
+		return decrement(ib)
+	case b == "":
+		ia := integerPart(a)
+		if i, ok := increment(ia); ok {
+			return i, true
+		}
+		return ia + midpoint(a[len(ia):], ""), true
+	}
+
+	ia, ib := integerPart(a), integerPart(b)
+	fa, fb := a[len(ia):], b[len(ib):]
+	if ia == ib {
+		return ia + midpoint(fa, fb), true
+	}
+	if i, ok := increment(ia); ok && i < b {
+		return i, true
+	}
+	return ia + midpoint(fa, ""), true
+}
+
+// After returns a key that sorts after a, or Initial when a is empty.
+func After(a string) (string, bool) {
+	return Between(a, "")
+}
+
+// Before returns a key that sorts before b, or Initial when b is empty.
+func Before(b string) (string, bool) {
+	return Between("", b)
+}
+
+// integerLength returns the length of the integer part that starts with head.
+func integerLength(head byte) int {
+	switch {
+	case head >= 'a' && head <= 'z':
+		return int(head-'a') + 2
+	case head >= '0' && head <= '9':
+		return int('9'-head) + 2
+	}
+	return 0
+}
+
+func integerPart(key string) string {
+	return key[:integerLength(key[0])]
+}
+
+func valid(key string) bool {
+	if key == smallestInteger {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(digits, key[i]) < 0 {
+			return false
+		}
+	}
+	n := integerLength(key[0])
+	if n == 0 || len(key) < n {
+		return false
+	}
+	return !strings.HasSuffix(key[n:], "0")
+}
+
+// increment returns the next integer part, or false when the largest integer is reached.
+func increment(integer string) (string, bool) {
+	head, ds := integer[0], []byte(integer[1:])
+	for i := len(ds) - 1; i >= 0; i-- {
+		if ds[i] != digits[len(digits)-1] {
+			ds[i] = digits[strings.IndexByte(digits, ds[i])+1]
+			return string(head) + string(ds), true
+		}
+		ds[i] = digits[0]
+	}
+
+	// 모든 자리가 최댓값이면 자릿수를 바꿉니다.
+	switch {
+	case head == '9':
+		return "a" + string(digits[0]), true
+	case head == 'z':
+		return "", false
+	case head >= 'a':
+		return string(head+1) + strings.Repeat(string(digits[0]), len(ds)+1), true
+	default:
+		return string(head+1) + strings.Repeat(string(digits[0]), len(ds)-1), true
+	}
+}
+
+// decrement returns the previous integer part, or false when the smallest integer is reached.
+func decrement(integer string) (string, bool) {
+	head, ds := integer[0], []byte(integer[1:])
+	for i := len(ds) - 1; i >= 0; i-- {
+		if ds[i] != digits[0] {
+			ds[i] = digits[strings.IndexByte(digits, ds[i])-1]
+			return string(head) + string(ds), true
+		}
+		ds[i] = digits[len(digits)-1]
+	}
+
+	max := string(digits[len(digits)-1])
+	switch {
+	case head == 'a':
+		return "9" + max, true
+	case head == '0':
+		return "", false
+	case head > 'a':
+		return string(head-1) + strings.Repeat(max, len(ds)-1), true
+	default:
+		return string(head-1) + strings.Repeat(max, len(ds)+1), true
+	}
+}
+
+// midpoint returns a fraction between a and b, where b == "" means no upper bound.
+// It assumes a < b and that neither fraction ends with the zero digit.
+func midpoint(a, b string) string {
+	if b != "" {
+		// 공통 접두사는 그대로 두고 나머지 부분의 중간값을 구합니다.
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(a) {
+				rest = a[n:]
+			}
+			return b[:n] + midpoint(rest, b[n:])
+		}
+	}
+
+	digitA := 0
+	if a != "" {
+		digitA = strings.IndexByte(digits, a[0])
+	}
+	digitB := len(digits)
+	if b != "" {
+		digitB = strings.IndexByte(digits, b[0])
+	}
+	if digitB-digitA > 1 {
+		return string(digits[(digitA+digitB+1)/2])
+	}
+	// 첫 자리가 연속된 경우 한 자리 더 내려갑니다.
+	if len(b) > 1 {
+		return b[:1]
+	}
+	rest := ""
+	if a != "" {
+		rest = a[1:]
+	}
+	return string(digits[digitA]) + midpoint(rest, "")
+}
+
+func digitAt(key string, i int) byte {
+	if i < len(key) {
+		return key[i]
+	}
+	return digits[0]
+}
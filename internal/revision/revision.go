@@ -0,0 +1,313 @@
+// Package revision records an immutable revision for every change of a todo
+// and keeps the version counter of the todo used for optimistic concurrency.
+//
+// The recording is done by ent hooks installed on the client, so every write path,
+// including bulk updates, the ones in transactions and changes of tags, is covered.
+package revision
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/hook"
+	"todo-api-golang/ent/schema"
+	"todo-api-golang/ent/tag"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/todorevision"
+	"todo-api-golang/middleware/auth"
+)
+
+// ignoredFields are not recorded: updated_at changes on every write,
+// reminded_at is bookkeeping of the reminder worker and version is kept by the hooks.
+var ignoredFields = map[string]bool{
+	todo.FieldUpdatedAt:  true,
+	todo.FieldRemindedAt: true,
+	todo.FieldVersion:    true,
+}
+
+// statusFields are the fields changed by a status transition.
+var statusFields = map[string]bool{
+	todo.FieldStatus:      true,
+	todo.FieldStartedAt:   true,
+	todo.FieldCompletedAt: true,
+}
+
+// Register installs the version and revision hooks on the client and makes revisions read-only.
+func Register(client *ent.Client) {
+	client.Todo.Use(VersionHook(), Hook())
+	client.Tag.Use(TagHook())
+	client.TodoRevision.Use(hook.Reject(ent.OpUpdate | ent.OpUpdateOne))
+}
+
+// Hook records a revision for each todo created or updated by a mutation.
+// The user is taken from the request context and is empty for background workers.
+// Deletes are not recorded because the revisions of a purged todo are deleted with it.
+func Hook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.TodoFunc(func(ctx context.Context, m *ent.TodoMutation) (ent.Value, error) {
+			if !recorded(m) {
+				return next.Mutate(ctx, m)
+			}
+			client := m.Client()
+
+			// 변경 전 상태는 변경이 적용되기 전에 읽어 두어야 합니다.
+			var (
+				ids    []int
+				before map[int]schema.TodoSnapshot
+				err    error
+			)
+			if !m.Op().Is(ent.OpCreate) {
+				if ids, err = m.IDs(ctx); err != nil {
+					return nil, err
+				}
+				if len(ids) == 0 {
+					return next.Mutate(ctx, m)
+				}
+				if before, err = snapshots(ctx, client, ids); err != nil {
+					return nil, err
+				}
+			}
+
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return value, err
+			}
+			if created, ok := value.(*ent.Todo); ok && m.Op().Is(ent.OpCreate) {
+				ids = []int{created.ID}
+			}
+			after, err := snapshots(ctx, client, ids)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err := writeRevisions(ctx, client, ids, before, after); err != nil {
+				return nil, err
+			}
+			if err := bumpParents(ctx, client, ids, before, after); err != nil {
+				return nil, err
+			}
+			return value, nil
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// TagHook records a revision for each todo whose tags are changed through a tag: by renaming
+// or deleting the tag, or by adding or removing its todos. The version of these todos is
+// incremented, since their ETags cover the names of their tags.
+func TagHook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.TagFunc(func(ctx context.Context, m *ent.TagMutation) (ent.Value, error) {
+			_, renamed := m.Name()
+			if !renamed && !m.Op().Is(ent.OpDelete|ent.OpDeleteOne) &&
+				len(m.TodosIDs()) == 0 && len(m.RemovedTodosIDs()) == 0 && !m.TodosCleared() {
+				return next.Mutate(ctx, m)
+			}
+			client := m.Client()
+
+			tagIDs, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			ids, err := client.Todo.Query().
+				Where(todo.HasTagsWith(tag.IDIn(tagIDs...))).
+				IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range m.TodosIDs() {
+				if !slices.Contains(ids, id) {
+					ids = append(ids, id)
+				}
+			}
+			if len(ids) == 0 {
+				return next.Mutate(ctx, m)
+			}
+			before, err := snapshots(ctx, client, ids)
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return value, err
+			}
+			after, err := snapshots(ctx, client, ids)
+			if err != nil {
+				return nil, err
+			}
+			changed, err := writeRevisions(ctx, client, ids, before, after)
+			if err != nil {
+				return nil, err
+			}
+			// 할 일의 변경이 아니므로 VersionHook 대신 여기서 버전을 올립니다.
+			if len(changed) > 0 {
+				err := client.Todo.Update().
+					Where(todo.IDIn(changed...)).
+					AddVersion(1).
+					Exec(ctx)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return value, nil
+		})
+	}, ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne)
+}
+
+// writeRevisions records a revision for each of the todos whose snapshot differs between before
+// and after, and returns their IDs. Todos missing from after, e.g. because they were deleted, are skipped.
+func writeRevisions(ctx context.Context, client *ent.Client, ids []int, before, after map[int]schema.TodoSnapshot) ([]int, error) {
+	var userID *int
+	if id, ok := auth.UserIDFromContext(ctx); ok {
+		userID = &id
+	}
+	var (
+		changed []int
+		creates []*ent.TodoRevisionCreate
+	)
+	for _, id := range ids {
+		current, ok := after[id]
+		if !ok {
+			continue
+		}
+		var previous *schema.TodoSnapshot
+		if snapshot, ok := before[id]; ok {
+			previous = &snapshot
+		}
+		changes, err := diff(previous, current)
+		if err != nil {
+			return nil, err
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		changed = append(changed, id)
+		creates = append(creates, client.TodoRevision.Create().
+			SetTodoID(id).
+			SetNillableUserID(userID).
+			SetAction(action(previous, current, changes)).
+			SetChanges(changes).
+			SetSnapshot(current))
+	}
+	if len(creates) > 0 {
+		if err := client.TodoRevision.CreateBulk(creates...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return changed, nil
+}
+
+// recorded reports whether the mutation may change a recorded field or the tags.
+func recorded(m *ent.TodoMutation) bool {
+	if m.Op().Is(ent.OpCreate) {
+		return true
+	}
+	if len(m.AddedEdges()) > 0 || len(m.RemovedEdges()) > 0 || len(m.ClearedEdges()) > 0 {
+		return true
+	}
+	for _, name := range append(m.Fields(), m.ClearedFields()...) {
+		if !ignoredFields[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// snapshots loads the recorded state of the given todos.
+func snapshots(ctx context.Context, client *ent.Client, ids []int) (map[int]schema.TodoSnapshot, error) {
+	todos, err := client.Todo.Query().
+		Where(todo.IDIn(ids...)).
+		WithTags(func(q *ent.TagQuery) { q.Order(tag.ByName()) }).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int]schema.TodoSnapshot, len(todos))
+	for _, t := range todos {
+		result[t.ID] = Snapshot(t)
+	}
+	return result, nil
+}
+
+// Snapshot converts a todo, with its tags loaded, into the state stored in a revision.
+func Snapshot(t *ent.Todo) schema.TodoSnapshot {
+	var tags []string
+	for _, tg := range t.Edges.Tags {
+		tags = append(tags, tg.Name)
+	}
+	return schema.TodoSnapshot{
+		Title:        t.Title,
+		Description:  t.Description,
+		Status:       t.Status,
+		Priority:     t.Priority,
+		Position:     t.Position,
+		ProjectID:    t.ProjectID,
+		ParentID:     t.ParentID,
+		AutoComplete: t.AutoComplete,
+		DueAt:        t.DueAt,
+		RemindAt:     t.RemindAt,
+		StartedAt:    t.StartedAt,
+		CompletedAt:  t.CompletedAt,
+		DeletedAt:    t.DeletedAt,
+		Tags:         tags,
+	}
+}
+
+// diff returns the fields that differ between two snapshots, keyed by their JSON name.
+// previous is nil for a new todo, whose fields are all recorded with a null old value.
+func diff(previous *schema.TodoSnapshot, current schema.TodoSnapshot) (map[string]schema.FieldChange, error) {
+	// JSON 표현으로 비교해야 저장된 값과 같은 형태로 기록됩니다.
+	var old map[string]interface{}
+	if previous != nil {
+		var err error
+		if old, err = fieldValues(*previous); err != nil {
+			return nil, err
+		}
+	}
+	cur, err := fieldValues(current)
+	if err != nil {
+		return nil, err
+	}
+	changes := make(map[string]schema.FieldChange)
+	for name, value := range cur {
+		if previous == nil && value == nil {
+			continue
+		}
+		if !reflect.DeepEqual(old[name], value) {
+			changes[name] = schema.FieldChange{Old: old[name], New: value}
+		}
+	}
+	return changes, nil
+}
+
+func fieldValues(snapshot schema.TodoSnapshot) (map[string]interface{}, error) {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// action classifies a change for the history.
+func action(previous *schema.TodoSnapshot, current schema.TodoSnapshot, changes map[string]schema.FieldChange) todorevision.Action {
+	switch {
+	case previous == nil:
+		return todorevision.ActionCreate
+	case previous.DeletedAt == nil && current.DeletedAt != nil:
+		return todorevision.ActionDelete
+	case previous.DeletedAt != nil && current.DeletedAt == nil:
+		return todorevision.ActionRestore
+	}
+	for name := range changes {
+		if !statusFields[name] {
+			return todorevision.ActionUpdate
+		}
+	}
+	return todorevision.ActionStatus
+}
@@ -0,0 +1,72 @@
+package revision
+
+import (
+	"context"
+	"slices"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/hook"
+	"todo-api-golang/ent/schema"
+	"todo-api-golang/ent/todo"
+)
+
+// VersionHook increments the version of the todos changed by an update.
+// Changes of the ignored bookkeeping fields keep the version, so that they do not
+// invalidate the ETags held by clients. Todos whose tags are changed through a tag
+// get their new version from TagHook, and parents whose progress changes from Hook.
+func VersionHook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.TodoFunc(func(ctx context.Context, m *ent.TodoMutation) (ent.Value, error) {
+			if recorded(m) {
+				m.AddVersion(1)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// bumpParents increments the version of the parents whose progress is changed between before
+// and after, because a subtask was added, moved away, trashed, restored, completed or reopened.
+// The progress is part of a todo's representation, so its ETag has to change with it.
+func bumpParents(ctx context.Context, client *ent.Client, ids []int, before, after map[int]schema.TodoSnapshot) error {
+	var parents []int
+	add := func(id *int) {
+		if id != nil && !slices.Contains(parents, *id) {
+			parents = append(parents, *id)
+		}
+	}
+	for _, id := range ids {
+		current, ok := after[id]
+		if !ok {
+			continue
+		}
+		previous, ok := before[id]
+		if !ok {
+			if current.DeletedAt == nil {
+				add(current.ParentID)
+			}
+			continue
+		}
+		if sameID(previous.ParentID, current.ParentID) &&
+			(previous.DeletedAt == nil) == (current.DeletedAt == nil) &&
+			(previous.CompletedAt == nil) == (current.CompletedAt == nil) {
+			continue
+		}
+		add(previous.ParentID)
+		add(current.ParentID)
+	}
+	if len(parents) == 0 {
+		return nil
+	}
+	// 버전만 바꾸는 변경은 기록되지 않으므로 VersionHook과 Hook이 버전을 다시 올리지 않습니다.
+	return client.Todo.Update().
+		Where(todo.IDIn(parents...)).
+		AddVersion(1).
+		Exec(ctx)
+}
+
+func sameID(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,46 @@
+package revision
+
+import (
+	"context"
+	"testing"
+	"time"
+	"todo-api-golang/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestSubtaskChangesBumpParentVersion(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	Register(client)
+
+	user := client.User.Create().SetEmail("parent@example.com").SetDisplayName("parent").SetPasswordHash("x").SaveX(ctx)
+	parent := client.Todo.Create().SetTitle("parent").SetPosition("a0").SetUserID(user.ID).SaveX(ctx)
+	other := client.Todo.Create().SetTitle("other").SetPosition("a1").SetUserID(user.ID).SaveX(ctx)
+	version := func(id int) int { return client.Todo.GetX(ctx, id).Version }
+
+	child := client.Todo.Create().SetTitle("child").SetPosition("a0").SetUserID(user.ID).SetParentID(parent.ID).SaveX(ctx)
+	steps := []struct {
+		name   string
+		change func()
+		parent int
+		other  int
+	}{
+		{"create", func() {}, 2, 1},
+		{"complete", func() { client.Todo.UpdateOneID(child.ID).SetCompletedAt(time.Now()).ExecX(ctx) }, 3, 1},
+		{"retitle", func() { client.Todo.UpdateOneID(child.ID).SetTitle("renamed").ExecX(ctx) }, 3, 1},
+		{"trash", func() { client.Todo.UpdateOneID(child.ID).SetDeletedAt(time.Now()).ExecX(ctx) }, 4, 1},
+		{"restore", func() { client.Todo.UpdateOneID(child.ID).ClearDeletedAt().ExecX(ctx) }, 5, 1},
+		{"reparent", func() { client.Todo.UpdateOneID(child.ID).SetParentID(other.ID).ExecX(ctx) }, 6, 2},
+	}
+	for _, step := range steps {
+		step.change()
+		if got := version(parent.ID); got != step.parent {
+			t.Errorf("after %s: version of the parent = %d, want %d", step.name, got, step.parent)
+		}
+		if got := version(other.ID); got != step.other {
+			t.Errorf("after %s: version of the other todo = %d, want %d", step.name, got, step.other)
+		}
+	}
+}
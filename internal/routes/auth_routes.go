@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"log"
+	"todo-api-golang/edge/database"
+	"todo-api-golang/edge/token"
+	"todo-api-golang/internal/handlers"
+	"todo-api-golang/internal/service"
+	"todo-api-golang/util"
+)
+
+func AuthRoutes() chi.Router {
+	r := chi.NewRouter()
+
+	config, err := util.LoadConfig(".")
+	if err != nil {
+		log.Fatal("cannot load config:", err)
+	}
+	client := database.InitDB()
+
+	authService := service.NewAuthService(client, token.InitMaker(), config)
+	authHandlers := handlers.NewAuthHandler(authService)
+
+	r.Post("/login", authHandlers.Login)
+	r.Post("/refresh", authHandlers.Refresh)
+	r.Post("/logout", authHandlers.Logout)
+
+	return r
+}
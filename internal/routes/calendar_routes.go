@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"log"
+	"todo-api-golang/edge/database"
+	"todo-api-golang/edge/token"
+	"todo-api-golang/internal/handlers"
+	"todo-api-golang/internal/service"
+	"todo-api-golang/internal/workflow"
+	"todo-api-golang/middleware/auth"
+	"todo-api-golang/util"
+)
+
+func CalendarRoutes(wf *workflow.Workflow) chi.Router {
+	r := chi.NewRouter()
+
+	config, err := util.LoadConfig(".")
+	if err != nil {
+		log.Fatal("cannot load config:", err)
+	}
+	client := database.InitDB()
+
+	calendarService := service.NewCalendarService(client)
+	todoService := service.NewTodoService(client, wf, config)
+	calendarHandlers := handlers.NewCalendarHandler(calendarService, todoService)
+
+	// 캘린더 앱은 Authorization 헤더를 보낼 수 없으므로 피드는 URL의 토큰으로 인증합니다.
+	r.With(auth.FeedToken(auth.FeedTokenParam, calendarService.FeedUserID)).Get("/feed.ics", calendarHandlers.GetFeed)
+
+	r.Group(func(r chi.Router) {
+		r.Use(auth.Authenticator(token.InitMaker()))
+
+		r.Post("/token", calendarHandlers.IssueFeedToken)
+		r.Delete("/token", calendarHandlers.RevokeFeedToken)
+	})
+
+	return r
+}
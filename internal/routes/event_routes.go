@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"todo-api-golang/edge/database"
+	"todo-api-golang/edge/token"
+	"todo-api-golang/internal/events"
+	"todo-api-golang/internal/handlers"
+	"todo-api-golang/internal/service"
+	"todo-api-golang/middleware/auth"
+)
+
+func EventRoutes() chi.Router {
+	r := chi.NewRouter()
+
+	client := database.InitDB()
+
+	eventService := service.NewEventService(client, events.InitBroker())
+	eventHandlers := handlers.NewEventHandler(eventService)
+
+	r.Use(auth.QueryToken(auth.AccessTokenParam))
+	r.Use(auth.Authenticator(token.InitMaker()))
+
+	r.Get("/", eventHandlers.StreamEvents)
+	r.Get("/ws", eventHandlers.StreamEventsWebSocket)
+
+	return r
+}
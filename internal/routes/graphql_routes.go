@@ -0,0 +1,66 @@
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"log"
+	"todo-api-golang/edge/database"
+	"todo-api-golang/edge/token"
+	"todo-api-golang/internal/events"
+	"todo-api-golang/internal/graph"
+	"todo-api-golang/internal/handlers"
+	"todo-api-golang/internal/service"
+	"todo-api-golang/internal/workflow"
+	"todo-api-golang/middleware/auth"
+	"todo-api-golang/util"
+)
+
+func GraphQLRoutes(wf *workflow.Workflow) chi.Router {
+	r := chi.NewRouter()
+
+	config, err := util.LoadConfig(".")
+	if err != nil {
+		log.Fatal("cannot load config:", err)
+	}
+	graphQLHandlers := newGraphQLHandler(config, wf)
+
+	// GraphiQL은 개발 환경에서만 제공합니다. 토큰은 페이지의 헤더 편집기에서 입력합니다.
+	if config.Environment != "production" {
+		r.Get("/", graphQLHandlers.Playground)
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Use(auth.Authenticator(token.InitMaker()))
+		r.Post("/", graphQLHandlers.Query)
+	})
+
+	return r
+}
+
+// GraphQLSubscriptionRoutes serves subscriptions over WebSocket. It is mounted apart from GraphQLRoutes
+// because the connections stay open.
+func GraphQLSubscriptionRoutes(wf *workflow.Workflow) chi.Router {
+	r := chi.NewRouter()
+
+	config, err := util.LoadConfig(".")
+	if err != nil {
+		log.Fatal("cannot load config:", err)
+	}
+	graphQLHandlers := newGraphQLHandler(config, wf)
+
+	r.Use(auth.QueryToken(auth.AccessTokenParam))
+	r.Use(auth.Authenticator(token.InitMaker()))
+	r.Get("/", graphQLHandlers.Subscribe)
+
+	return r
+}
+
+func newGraphQLHandler(config util.Config, wf *workflow.Workflow) handlers.GraphQLHandlerInterface {
+	client := database.InitDB()
+
+	schema := graph.NewSchema(
+		client,
+		service.NewTodoService(client, wf, config),
+		service.NewEventService(client, events.InitBroker()),
+	)
+	return handlers.NewGraphQLHandler(graph.NewServer(schema))
+}
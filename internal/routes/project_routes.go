@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"todo-api-golang/edge/database"
+	"todo-api-golang/edge/token"
+	"todo-api-golang/internal/handlers"
+	"todo-api-golang/internal/service"
+	"todo-api-golang/middleware/auth"
+)
+
+func ProjectRoutes() chi.Router {
+	r := chi.NewRouter()
+
+	client := database.InitDB()
+
+	projectService := service.NewProjectService(client)
+	projectHandlers := handlers.NewProjectHandler(projectService)
+
+	r.Use(auth.Authenticator(token.InitMaker()))
+
+	r.Post("/", projectHandlers.CreateProject)
+	r.Get("/", projectHandlers.ListProjects)
+	r.Get("/{id}", projectHandlers.GetProject)
+	r.Put("/{id}", projectHandlers.UpdateProject)
+	r.Delete("/{id}", projectHandlers.DeleteProject)
+	r.Put("/{id}/archive", projectHandlers.ArchiveProject)
+	r.Put("/{id}/unarchive", projectHandlers.UnarchiveProject)
+	r.Put("/{id}/restore", projectHandlers.RestoreProject)
+
+	return r
+}
@@ -6,16 +6,24 @@ import (
 	"github.com/go-chi/cors"
 	httpSwagger "github.com/swaggo/http-swagger"
 
+	"log"
+	"net/http"
+	"os"
 	"time"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/workflow"
+	response "todo-api-golang/middleware"
+	"todo-api-golang/middleware/auth"
 )
 
-func Router() *chi.Mux {
+// Router creates the HTTP router. The todo routes follow the given workflow.
+func Router(wf *workflow.Workflow) *chi.Mux {
 
 	r := chi.NewRouter()
 
 	applyCorsMiddleware(r)
 	applyStandardMiddleware(r)
-	setupRegisterRoutes(r)
+	setupRegisterRoutes(r, wf)
 
 	return r
 }
@@ -23,30 +31,65 @@ func Router() *chi.Mux {
 // Middleware 순서 중요
 func applyStandardMiddleware(r *chi.Mux) {
 	r.Use(gochi_middleware.RealIP)
-	r.Use(gochi_middleware.Logger)
+	r.Use(gochi_middleware.RequestLogger(&logFormatter{DefaultLogFormatter: gochi_middleware.DefaultLogFormatter{
+		Logger: log.New(os.Stdout, "", log.LstdFlags),
+	}}))
 	r.Use(gochi_middleware.Recoverer)
-	r.Use(gochi_middleware.Timeout(60 * time.Second))
-	r.Use(gochi_middleware.Throttle(100))
 	r.Use(gochi_middleware.Compress(5))
 	r.Use(gochi_middleware.AllowContentEncoding("application/json", "application/x-www-form-urlencoded"))
 	r.Use(gochi_middleware.CleanPath)
 	r.Use(gochi_middleware.RedirectSlashes)
 }
 
+// logFormatter는 chi의 기본 형식으로 요청을 기록하되 쿼리로 전달된 토큰은 가립니다.
+type logFormatter struct {
+	gochi_middleware.DefaultLogFormatter
+}
+
+func (f *logFormatter) NewLogEntry(r *http.Request) gochi_middleware.LogEntry {
+	if uri := auth.RedactedRequestURI(r); uri != r.RequestURI {
+		r = r.WithContext(r.Context())
+		r.RequestURI = uri
+	}
+	return f.DefaultLogFormatter.NewLogEntry(r)
+}
+
 func applyCorsMiddleware(r *chi.Mux) {
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"https://*", "http://*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "If-Match", "If-None-Match", "Idempotency-Key", "Last-Event-ID"},
+		ExposedHeaders:   []string{"Link", "ETag", "Idempotent-Replayed"},
 		AllowCredentials: true,
 		MaxAge:           300,
 	}))
 }
 
-func setupRegisterRoutes(r *chi.Mux) {
-	r.Get("/swagger/*", httpSwagger.WrapHandler)
-	r.Mount("/api/v1/todos", TodoRoutes())
-	r.Mount("/api/v1/users", UserRoutes())
-	// 새로운 라우트를 추가하려면 여기서 r.Mount()를 호출합니다.
+func setupRegisterRoutes(r *chi.Mux, wf *workflow.Workflow) {
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		response.ResponseError(w, apperror.New(apperror.CodeRouteNotFound, "Route not found"))
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		response.ResponseError(w, apperror.New(apperror.CodeMethodNotAllowed, "Method not allowed"))
+	})
+
+	r.Group(func(r chi.Router) {
+		r.Use(gochi_middleware.Timeout(60 * time.Second))
+		r.Use(gochi_middleware.Throttle(100))
+
+		r.Get("/swagger/*", httpSwagger.WrapHandler)
+		r.Mount("/api/v1/todos", TodoRoutes(wf))
+		r.Mount("/api/v1/projects", ProjectRoutes())
+		r.Mount("/api/v1/tags", TagRoutes())
+		r.Mount("/api/v1/webhooks", WebhookRoutes())
+		r.Mount("/api/v1/calendar", CalendarRoutes(wf))
+		r.Mount("/api/v1/users", UserRoutes())
+		r.Mount("/api/v1/auth", AuthRoutes())
+		r.Mount("/graphql", GraphQLRoutes(wf))
+		// 새로운 라우트를 추가하려면 여기서 r.Mount()를 호출합니다.
+	})
+
+	// 이벤트 스트림은 연결을 계속 유지하므로 타임아웃과 동시 요청 제한 없이 등록합니다.
+	r.Mount("/api/v1/todos/events", EventRoutes())
+	r.Mount("/graphql/ws", GraphQLSubscriptionRoutes(wf))
 }
@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"todo-api-golang/edge/database"
+	"todo-api-golang/edge/token"
+	"todo-api-golang/internal/handlers"
+	"todo-api-golang/internal/service"
+	"todo-api-golang/middleware/auth"
+)
+
+func TagRoutes() chi.Router {
+	r := chi.NewRouter()
+
+	client := database.InitDB()
+
+	tagService := service.NewTagService(client)
+	tagHandlers := handlers.NewTagHandler(tagService)
+
+	r.Use(auth.Authenticator(token.InitMaker()))
+
+	r.Post("/", tagHandlers.CreateTag)
+	r.Get("/", tagHandlers.ListTags)
+	r.Put("/{id}", tagHandlers.RenameTag)
+	r.Delete("/{id}", tagHandlers.DeleteTag)
+	r.Post("/{id}/merge", tagHandlers.MergeTag)
+
+	return r
+}
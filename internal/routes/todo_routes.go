@@ -2,25 +2,53 @@ package routes
 
 import (
 	"github.com/go-chi/chi/v5"
+	"log"
 	"todo-api-golang/edge/database"
+	"todo-api-golang/edge/token"
 	"todo-api-golang/internal/handlers"
 	"todo-api-golang/internal/service"
+	"todo-api-golang/internal/workflow"
+	"todo-api-golang/middleware/auth"
+	"todo-api-golang/middleware/idempotency"
+	"todo-api-golang/util"
 )
 
-func TodoRoutes() chi.Router {
+func TodoRoutes(wf *workflow.Workflow) chi.Router {
 	r := chi.NewRouter()
 
+	config, err := util.LoadConfig(".")
+	if err != nil {
+		log.Fatal("cannot load config:", err)
+	}
 	client := database.InitDB()
 
-	todoService := service.NewTodoService(client)
-	todoHandlers := handlers.NewTodoHandler(todoService)
+	todoService := service.NewTodoService(client, wf, config)
+	todoHandlers := handlers.NewTodoHandler(todoService, service.NewProjectService(client))
+
+	r.Use(auth.Authenticator(token.InitMaker()))
+	r.Use(idempotency.Middleware(idempotency.NewEntStore(client), config.IdempotencyTTL))
 
 	r.Post("/", todoHandlers.CreateTodo)
 	r.Get("/", todoHandlers.ListTodos)
+	r.Get("/workflow", todoHandlers.GetWorkflow)
+	r.Post("/bulk", todoHandlers.BulkUpdateTodos)
+	r.Get("/export", todoHandlers.ExportTodos)
+	r.Post("/import", todoHandlers.ImportTodos)
+	r.Get("/trash", todoHandlers.ListTrash)
+	r.Delete("/trash/{id}", todoHandlers.PurgeTodo)
 	r.Get("/{id}", todoHandlers.GetTodo)
 	r.Put("/{id}", todoHandlers.UpdateTodo)
+	r.Patch("/{id}", todoHandlers.PatchTodo)
 	r.Delete("/{id}", todoHandlers.DeleteTodo)
 	r.Put("/{id}/status", todoHandlers.UpdateTodoStatus)
+	r.Get("/{id}/transitions", todoHandlers.ListTodoTransitions)
+	r.Get("/{id}/history", todoHandlers.ListTodoHistory)
+	r.Post("/{id}/history/{revisionID}/revert", todoHandlers.RevertTodo)
+	r.Put("/{id}/move", todoHandlers.MoveTodo)
+	r.Put("/{id}/parent", todoHandlers.SetTodoParent)
+	r.Post("/{id}/subtasks", todoHandlers.CreateSubtask)
+	r.Get("/{id}/subtasks", todoHandlers.ListSubtasks)
+	r.Put("/{id}/restore", todoHandlers.RestoreTodo)
 
 	return r
 }
@@ -2,10 +2,30 @@ package routes
 
 import (
 	"github.com/go-chi/chi/v5"
+	"todo-api-golang/edge/database"
+	"todo-api-golang/edge/token"
+	"todo-api-golang/internal/handlers"
+	"todo-api-golang/internal/service"
+	"todo-api-golang/middleware/auth"
 )
 
 func UserRoutes() chi.Router {
 	r := chi.NewRouter()
 
+	client := database.InitDB()
+
+	userService := service.NewUserService(client)
+	userHandlers := handlers.NewUserHandler(userService)
+
+	r.Post("/", userHandlers.Signup)
+
+	r.Group(func(r chi.Router) {
+		r.Use(auth.Authenticator(token.InitMaker()))
+
+		r.Get("/me", userHandlers.GetUser)
+		r.Put("/me", userHandlers.UpdateUser)
+		r.Delete("/me", userHandlers.DeleteUser)
+	})
+
 	return r
 }
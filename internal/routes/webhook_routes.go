@@ -0,0 +1,37 @@
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"log"
+	"todo-api-golang/edge/database"
+	"todo-api-golang/edge/token"
+	"todo-api-golang/internal/handlers"
+	"todo-api-golang/internal/service"
+	"todo-api-golang/middleware/auth"
+	"todo-api-golang/util"
+)
+
+func WebhookRoutes() chi.Router {
+	r := chi.NewRouter()
+
+	config, err := util.LoadConfig(".")
+	if err != nil {
+		log.Fatal("cannot load config:", err)
+	}
+	client := database.InitDB()
+
+	webhookService := service.NewWebhookService(client, config)
+	webhookHandlers := handlers.NewWebhookHandler(webhookService)
+
+	r.Use(auth.Authenticator(token.InitMaker()))
+
+	r.Post("/", webhookHandlers.CreateWebhook)
+	r.Get("/", webhookHandlers.ListWebhooks)
+	r.Get("/{id}", webhookHandlers.GetWebhook)
+	r.Put("/{id}", webhookHandlers.UpdateWebhook)
+	r.Delete("/{id}", webhookHandlers.DeleteWebhook)
+	r.Get("/{id}/deliveries", webhookHandlers.ListDeliveries)
+	r.Post("/{id}/deliveries/{deliveryID}/redeliver", webhookHandlers.RedeliverDelivery)
+
+	return r
+}
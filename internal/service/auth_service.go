@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"todo-api-golang/edge/token"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/session"
+	"todo-api-golang/ent/user"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/util"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrInvalidCredentials = apperror.New(apperror.CodeInvalidCredentials, "Invalid email or password")
+	ErrInvalidSession     = apperror.New(apperror.CodeInvalidRefreshToken, "Invalid refresh token")
+)
+
+// dummyPasswordHash is compared against when logging in with an unknown email, so that the response
+// takes as long as for a registered one and does not reveal which emails are registered.
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy password"), bcrypt.DefaultCost)
+
+// AuthService defines the interface for authentication operations and implements it.
+type AuthService interface {
+	Login(ctx context.Context, form dto.LoginForm, client dto.ClientInfo) (*dto.TokenDTO, error)
+	Refresh(ctx context.Context, refreshToken string, client dto.ClientInfo) (*dto.TokenDTO, error)
+	Logout(ctx context.Context, refreshToken string) error
+}
+
+// authService is the concrete implementation of AuthService.
+type authService struct {
+	client *ent.Client
+	maker  token.Maker
+	config util.Config
+}
+
+// NewAuthService creates a new instance of authService.
+func NewAuthService(client *ent.Client, maker token.Maker, config util.Config) AuthService {
+	return &authService{client: client, maker: maker, config: config}
+}
+
+// Implement the methods defined in the AuthService interface.
+
+func (s *authService) Login(ctx context.Context, form dto.LoginForm, client dto.ClientInfo) (*dto.TokenDTO, error) {
+	userItem, err := s.client.User.Query().
+		Where(user.Email(normalizeEmail(form.Email))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(form.Password))
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(userItem.PasswordHash), []byte(form.Password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	return s.issueTokens(ctx, s.client, userItem, client)
+}
+
+func (s *authService) Refresh(ctx context.Context, refreshToken string, client dto.ClientInfo) (*dto.TokenDTO, error) {
+	payload, err := s.maker.VerifyToken(refreshToken, token.TypeRefresh)
+	if err != nil {
+		return nil, ErrInvalidSession
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 리프레시 토큰은 한 번만 사용할 수 있도록 기존 세션을 폐기하고 새 세션을 발급합니다.
+	revoked, err := tx.Session.Update().
+		Where(
+			session.ID(payload.ID),
+			session.UserID(payload.UserID),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(time.Now()),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if revoked == 0 {
+		return nil, rollback(tx, ErrInvalidSession)
+	}
+
+	userItem, err := tx.User.Get(ctx, payload.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, rollback(tx, ErrInvalidSession)
+		}
+		return nil, rollback(tx, err)
+	}
+
+	tokenDTO, err := s.issueTokens(ctx, tx.Client(), userItem, client)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return tokenDTO, nil
+}
+
+func (s *authService) Logout(ctx context.Context, refreshToken string) error {
+	payload, err := s.maker.VerifyToken(refreshToken, token.TypeRefresh)
+	if err != nil {
+		return ErrInvalidSession
+	}
+
+	_, err = s.client.Session.Update().
+		Where(
+			session.ID(payload.ID),
+			session.UserID(payload.UserID),
+			session.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	return err
+}
+
+// issueTokens creates a new access/refresh token pair and stores the refresh session.
+func (s *authService) issueTokens(ctx context.Context, client *ent.Client, userItem *ent.User, info dto.ClientInfo) (*dto.TokenDTO, error) {
+	accessToken, accessPayload, err := s.maker.CreateToken(userItem.ID, token.TypeAccess, s.config.Access_Token_Duration)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, refreshPayload, err := s.maker.CreateToken(userItem.ID, token.TypeRefresh, s.config.Refresh_Token_Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.Session.Create().
+		SetID(refreshPayload.ID).
+		SetUserID(userItem.ID).
+		SetUserAgent(info.UserAgent).
+		SetClientIP(info.ClientIP).
+		SetExpiresAt(refreshPayload.ExpiredAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.TokenDTO{
+		TokenType:             "Bearer",
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessPayload.ExpiredAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshPayload.ExpiredAt,
+		User:                  dto.ConvertUserToDTO(userItem),
+	}, nil
+}
+
+// rollback rolls back the transaction and returns the original error.
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return errors.Join(err, rerr)
+	}
+	return err
+}
+
+// skipLocked locks the rows selected in a transaction and skips the rows locked by another one,
+// so that workers running in several instances claim different rows. SQLite has no row locks and
+// runs one write transaction at a time, so the rows are selected as they are there.
+func skipLocked(s *sql.Selector) {
+	if s.Dialect() != dialect.SQLite {
+		s.ForUpdate(sql.WithLockAction(sql.SkipLocked))
+	}
+}
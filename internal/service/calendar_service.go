@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/user"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+)
+
+// CalendarFeedPath is the path of the calendar feed, which is authenticated by the token query parameter.
+const CalendarFeedPath = "/api/v1/calendar/feed.ics"
+
+// CalendarService defines the interface for the tokens of the calendar feed and implements it.
+// Calendar apps cannot send a bearer token, so the feed is authenticated by a long-lived token
+// in its URL instead. Only a hash of the token is stored.
+type CalendarService interface {
+	// IssueFeedToken generates a token for the calendar feed of the user, replacing the previous one.
+	IssueFeedToken(ctx context.Context, userID int) (*dto.CalendarTokenDTO, error)
+	// RevokeFeedToken removes the token of the calendar feed of the user, which stops the feed.
+	RevokeFeedToken(ctx context.Context, userID int) error
+	// FeedUserID returns the ID of the user the token of a calendar feed was issued to.
+	FeedUserID(ctx context.Context, token string) (int, error)
+}
+
+// calendarService is the concrete implementation of CalendarService.
+type calendarService struct {
+	client *ent.Client
+}
+
+// NewCalendarService creates a new instance of calendarService.
+func NewCalendarService(client *ent.Client) CalendarService {
+	return &calendarService{client: client}
+}
+
+func (s *calendarService) IssueFeedToken(ctx context.Context, userID int) (*dto.CalendarTokenDTO, error) {
+	feedToken, err := newFeedToken()
+	if err != nil {
+		return nil, err
+	}
+	err = s.client.User.UpdateOneID(userID).
+		SetCalendarTokenHash(hashFeedToken(feedToken)).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperror.Wrap(err, apperror.CodeUserNotFound, "User not found")
+		}
+		return nil, err
+	}
+	return &dto.CalendarTokenDTO{
+		Token:   feedToken,
+		FeedURL: CalendarFeedPath + "?" + url.Values{"token": {feedToken}}.Encode(),
+	}, nil
+}
+
+func (s *calendarService) RevokeFeedToken(ctx context.Context, userID int) error {
+	err := s.client.User.UpdateOneID(userID).
+		ClearCalendarTokenHash().
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return apperror.Wrap(err, apperror.CodeUserNotFound, "User not found")
+	}
+	return err
+}
+
+func (s *calendarService) FeedUserID(ctx context.Context, token string) (int, error) {
+	userID, err := s.client.User.Query().
+		Where(user.CalendarTokenHash(hashFeedToken(token))).
+		OnlyID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, apperror.Wrap(err, apperror.CodeInvalidToken, "Invalid feed token")
+		}
+		return 0, err
+	}
+	return userID, nil
+}
+
+// newFeedToken generates a random token for a calendar feed.
+func newFeedToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "cal_" + hex.EncodeToString(b), nil
+}
+
+// hashFeedToken returns the stored form of a feed token. The token is random and long enough
+// that a plain SHA-256 without salt cannot be reversed.
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
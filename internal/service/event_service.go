@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/todorevision"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/events"
+)
+
+// MaxEventBacklog is the number of missed events replayed when a feed is resumed.
+const MaxEventBacklog = 1000
+
+// EventService defines the interface for the change feed of todos and implements it.
+type EventService interface {
+	// Subscribe streams the change events of the user's todos until ctx is done.
+	// When lastEventID is not 0, the events recorded after it are sent first.
+	// The stream is closed when the subscriber falls behind and has to resume from its last event.
+	Subscribe(ctx context.Context, userID, lastEventID int) (<-chan dto.TodoEventDTO, error)
+}
+
+// eventService is the concrete implementation of EventService.
+type eventService struct {
+	client *ent.Client
+	broker events.Broker
+}
+
+// NewEventService creates a new instance of eventService.
+func NewEventService(client *ent.Client, broker events.Broker) EventService {
+	return &eventService{client: client, broker: broker}
+}
+
+// Implement the methods defined in the EventService interface.
+
+func (s *eventService) Subscribe(ctx context.Context, userID, lastEventID int) (<-chan dto.TodoEventDTO, error) {
+	// 놓친 이벤트를 읽는 동안 발생한 이벤트도 받도록 먼저 구독합니다.
+	sub := s.broker.Subscribe(userID)
+	var backlog []events.Event
+	if lastEventID > 0 {
+		var err error
+		if backlog, err = s.backlog(ctx, userID, lastEventID); err != nil {
+			sub.Close()
+			return nil, err
+		}
+	}
+
+	stream := make(chan dto.TodoEventDTO)
+	go func() {
+		defer close(stream)
+		defer sub.Close()
+
+		send := func(event events.Event) bool {
+			select {
+			case stream <- dto.ConvertTodoEventToDTO(event):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		sent := make(map[int]bool, len(backlog))
+		for _, event := range backlog {
+			sent[event.ID] = true
+			if !send(event) {
+				return
+			}
+		}
+		for {
+			select {
+			case event, ok := <-sub.Events():
+				if !ok {
+					return
+				}
+				if sent[event.ID] {
+					continue
+				}
+				if !send(event) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return stream, nil
+}
+
+// backlog loads the events of the user recorded after lastEventID.
+func (s *eventService) backlog(ctx context.Context, userID, lastEventID int) ([]events.Event, error) {
+	revisions, err := s.client.TodoRevision.Query().
+		Where(todorevision.IDGT(lastEventID), todorevision.HasTodoWith(todo.UserID(userID))).
+		Order(todorevision.ByID()).
+		Limit(MaxEventBacklog + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) > MaxEventBacklog {
+		return nil, apperror.New(apperror.CodeConflict, "Too many events were missed; reload the todos and subscribe again without Last-Event-ID")
+	}
+	backlog := make([]events.Event, len(revisions))
+	for i, revision := range revisions {
+		backlog[i] = events.New(userID, revision)
+	}
+	return backlog, nil
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"time"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/project"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+)
+
+// ProjectService defines the interface for Project operations and implements it.
+// Every method is scoped to the projects owned by the given user.
+type ProjectService interface {
+	CreateProject(ctx context.Context, userID int, form dto.ProjectForm) (*dto.ProjectDTO, error)
+	// ListProjects returns the user's projects; a non-nil archived only returns the projects with that flag.
+	ListProjects(ctx context.Context, userID int, archived *bool) ([]dto.ProjectDTO, error)
+	GetProjectByID(ctx context.Context, userID, id int) (*dto.ProjectDTO, error)
+	UpdateProject(ctx context.Context, userID, id int, form dto.ProjectForm) (*dto.ProjectDTO, error)
+	SetProjectArchived(ctx context.Context, userID, id int, archived bool) (*dto.ProjectDTO, error)
+	// DeleteProject moves the project and all of its todos to the trash.
+	DeleteProject(ctx context.Context, userID, id int) error
+	// RestoreProject restores the project together with the todos that were trashed with it.
+	RestoreProject(ctx context.Context, userID, id int) (*dto.ProjectDTO, error)
+	// PurgeExpiredTrash permanently deletes the projects of every user that were soft-deleted before the given time.
+	PurgeExpiredTrash(ctx context.Context, before time.Time) (int, error)
+}
+
+// projectService is the concrete implementation of ProjectService.
+type projectService struct {
+	client *ent.Client
+}
+
+// NewProjectService creates a new instance of projectService.
+func NewProjectService(client *ent.Client) ProjectService {
+	return &projectService{client: client}
+}
+
+// Implement the methods defined in the ProjectService interface.
+
+func (s *projectService) CreateProject(ctx context.Context, userID int, form dto.ProjectForm) (*dto.ProjectDTO, error) {
+	projectItem, err := s.client.Project.Create().
+		SetName(form.Name).
+		SetColor(form.Color).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projectDTO := dto.ConvertProjectToDTO(projectItem)
+	return &projectDTO, nil
+}
+
+func (s *projectService) ListProjects(ctx context.Context, userID int, archived *bool) ([]dto.ProjectDTO, error) {
+	query := s.client.Project.Query().
+		Where(project.UserID(userID), project.DeletedAtIsNil())
+	if archived != nil {
+		query.Where(project.Archived(*archived))
+	}
+	projects, err := query.Order(project.ByName(), project.ByID()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projectDTOs := make([]dto.ProjectDTO, len(projects))
+	for i, p := range projects {
+		projectDTOs[i] = dto.ConvertProjectToDTO(p)
+	}
+	return projectDTOs, nil
+}
+
+func (s *projectService) GetProjectByID(ctx context.Context, userID, id int) (*dto.ProjectDTO, error) {
+	projectItem, err := s.client.Project.Query().
+		Where(project.ID(id), project.UserID(userID), project.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, projectError(err, "Project not found")
+	}
+	projectDTO := dto.ConvertProjectToDTO(projectItem)
+	return &projectDTO, nil
+}
+
+func (s *projectService) UpdateProject(ctx context.Context, userID, id int, form dto.ProjectForm) (*dto.ProjectDTO, error) {
+	projectItem, err := s.client.Project.UpdateOneID(id).
+		Where(project.UserID(userID), project.DeletedAtIsNil()).
+		SetName(form.Name).
+		SetColor(form.Color).
+		Save(ctx)
+	if err != nil {
+		return nil, projectError(err, "Project not found")
+	}
+	projectDTO := dto.ConvertProjectToDTO(projectItem)
+	return &projectDTO, nil
+}
+
+func (s *projectService) SetProjectArchived(ctx context.Context, userID, id int, archived bool) (*dto.ProjectDTO, error) {
+	projectItem, err := s.client.Project.UpdateOneID(id).
+		Where(project.UserID(userID), project.DeletedAtIsNil()).
+		SetArchived(archived).
+		Save(ctx)
+	if err != nil {
+		return nil, projectError(err, "Project not found")
+	}
+	projectDTO := dto.ConvertProjectToDTO(projectItem)
+	return &projectDTO, nil
+}
+
+func (s *projectService) DeleteProject(ctx context.Context, userID, id int) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 프로젝트와 함께 삭제된 할 일을 복원할 때 구분할 수 있도록 같은 시각을 기록합니다.
+	now := time.Now()
+	_, err = tx.Project.UpdateOneID(id).
+		Where(project.UserID(userID), project.DeletedAtIsNil()).
+		SetDeletedAt(now).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, projectError(err, "Project not found"))
+	}
+	err = tx.Todo.Update().
+		Where(todo.ProjectID(id), todo.DeletedAtIsNil()).
+		SetDeletedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func (s *projectService) RestoreProject(ctx context.Context, userID, id int) (*dto.ProjectDTO, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projectItem, err := tx.Project.Query().
+		Where(project.ID(id), project.UserID(userID), project.DeletedAtNotNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, projectError(err, "Project not found in trash"))
+	}
+	err = tx.Todo.Update().
+		Where(todo.ProjectID(id), todo.DeletedAt(*projectItem.DeletedAt)).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	projectItem, err = tx.Project.UpdateOne(projectItem).ClearDeletedAt().Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	projectDTO := dto.ConvertProjectToDTO(projectItem)
+	return &projectDTO, nil
+}
+
+func (s *projectService) PurgeExpiredTrash(ctx context.Context, before time.Time) (int, error) {
+	return s.client.Project.Delete().
+		Where(project.DeletedAtLT(before)).
+		Exec(ctx)
+}
+
+// projectError converts ent's not-found error into PROJECT_NOT_FOUND with the given message.
+func projectError(err error, notFoundMessage string) error {
+	if ent.IsNotFound(err) {
+		return apperror.Wrap(err, apperror.CodeProjectNotFound, notFoundMessage)
+	}
+	return err
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/predicate"
+	"todo-api-golang/ent/tag"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// TagService defines the interface for Tag operations and implements it.
+// Every method is scoped to the tags owned by the given user.
+type TagService interface {
+	CreateTag(ctx context.Context, userID int, form dto.TagForm) (*dto.TagDTO, error)
+	// ListTags returns the user's tags ordered by name, with their usage counts.
+	ListTags(ctx context.Context, userID int) ([]dto.TagDTO, error)
+	RenameTag(ctx context.Context, userID, id int, form dto.TagForm) (*dto.TagDTO, error)
+	DeleteTag(ctx context.Context, userID, id int) error
+	// MergeTags moves the todos of the source tag to the target tag and deletes the source tag.
+	MergeTags(ctx context.Context, userID, sourceID, targetID int) (*dto.TagDTO, error)
+}
+
+// tagService is the concrete implementation of TagService.
+type tagService struct {
+	client *ent.Client
+}
+
+// NewTagService creates a new instance of tagService.
+func NewTagService(client *ent.Client) TagService {
+	return &tagService{client: client}
+}
+
+// Implement the methods defined in the TagService interface.
+
+func (s *tagService) CreateTag(ctx context.Context, userID int, form dto.TagForm) (*dto.TagDTO, error) {
+	tagItem, err := s.client.Tag.Create().
+		SetName(normalizeTagName(form.Name)).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, tagError(err, "Tag not found")
+	}
+	tagDTO := dto.ConvertTagToDTO(tagItem, 0)
+	return &tagDTO, nil
+}
+
+func (s *tagService) ListTags(ctx context.Context, userID int) ([]dto.TagDTO, error) {
+	tags, err := s.client.Tag.Query().
+		Where(tag.UserID(userID)).
+		Order(tag.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := tagUsage(ctx, s.client, tag.UserID(userID))
+	if err != nil {
+		return nil, err
+	}
+	tagDTOs := make([]dto.TagDTO, len(tags))
+	for i, t := range tags {
+		tagDTOs[i] = dto.ConvertTagToDTO(t, counts[t.ID])
+	}
+	return tagDTOs, nil
+}
+
+func (s *tagService) RenameTag(ctx context.Context, userID, id int, form dto.TagForm) (*dto.TagDTO, error) {
+	// 태그가 붙은 할 일의 이력도 함께 기록되도록 트랜잭션 안에서 바꿉니다.
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Tag.UpdateOneID(id).
+		Where(tag.UserID(userID)).
+		SetName(normalizeTagName(form.Name)).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, tagError(err, "Tag not found"))
+	}
+	tagDTO, err := s.getTag(ctx, tx.Client(), id)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return tagDTO, nil
+}
+
+func (s *tagService) DeleteTag(ctx context.Context, userID, id int) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	err = tx.Tag.DeleteOneID(id).
+		Where(tag.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, tagError(err, "Tag not found"))
+	}
+	return tx.Commit()
+}
+
+func (s *tagService) MergeTags(ctx context.Context, userID, sourceID, targetID int) (*dto.TagDTO, error) {
+	if sourceID == targetID {
+		return nil, apperror.New(apperror.CodeBadRequest, "Cannot merge a tag into itself")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Tag.Query().Where(tag.ID(sourceID), tag.UserID(userID)).OnlyID(ctx); err != nil {
+		return nil, rollback(tx, tagError(err, "Tag not found"))
+	}
+	if _, err := tx.Tag.Query().Where(tag.ID(targetID), tag.UserID(userID)).OnlyID(ctx); err != nil {
+		return nil, rollback(tx, tagError(err, "Target tag not found"))
+	}
+
+	// 할 일을 갱신해 태그를 바꾸므로 할 일마다 이력이 하나씩 남습니다.
+	err = tx.Todo.Update().
+		Where(todo.HasTagsWith(tag.ID(sourceID))).
+		AddTagIDs(targetID).
+		RemoveTagIDs(sourceID).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Tag.DeleteOneID(sourceID).Exec(ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	tagDTO, err := s.getTag(ctx, tx.Client(), targetID)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return tagDTO, nil
+}
+
+// getTag returns the tag with its usage count.
+func (s *tagService) getTag(ctx context.Context, client *ent.Client, id int) (*dto.TagDTO, error) {
+	tagItem, err := client.Tag.Get(ctx, id)
+	if err != nil {
+		return nil, tagError(err, "Tag not found")
+	}
+	counts, err := tagUsage(ctx, client, tag.ID(id))
+	if err != nil {
+		return nil, err
+	}
+	tagDTO := dto.ConvertTagToDTO(tagItem, counts[id])
+	return &tagDTO, nil
+}
+
+// tagUsage counts the todos outside the trash per tag matching the predicates.
+// Tags without such todos are missing from the result.
+func tagUsage(ctx context.Context, client *ent.Client, ps ...predicate.Tag) (map[int]int, error) {
+	var rows []struct {
+		ID    int `json:"id"`
+		Count int `json:"count"`
+	}
+	// 태그별로 연결 테이블을 집계하므로 할 일을 불러오지 않습니다.
+	err := client.Tag.Query().
+		Where(ps...).
+		GroupBy(tag.FieldID).
+		Aggregate(func(s *sql.Selector) string {
+			edges := sql.Table(tag.TodosTable)
+			todos := sql.Table(todo.Table)
+			s.Join(edges).On(s.C(tag.FieldID), edges.C(tag.TodosPrimaryKey[0]))
+			s.Join(todos).On(edges.C(tag.TodosPrimaryKey[1]), todos.C(todo.FieldID))
+			s.Where(sql.IsNull(todos.C(todo.FieldDeletedAt)))
+			return sql.As(sql.Count("*"), "count")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int]int, len(rows))
+	for _, row := range rows {
+		counts[row.ID] = row.Count
+	}
+	return counts, nil
+}
+
+// normalizeTagName trims and lower-cases the name so that tags are matched case-insensitively.
+func normalizeTagName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// tagError converts ent's not-found and unique constraint errors into TAG_NOT_FOUND and TAG_NAME_TAKEN.
+func tagError(err error, notFoundMessage string) error {
+	switch {
+	case ent.IsNotFound(err):
+		return apperror.Wrap(err, apperror.CodeTagNotFound, notFoundMessage)
+	case ent.IsConstraintError(err):
+		return apperror.Wrap(err, apperror.CodeTagNameTaken, "Tag name already in use")
+	}
+	return err
+}
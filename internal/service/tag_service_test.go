@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/enttest"
+	"todo-api-golang/ent/todorevision"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/revision"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// tagFixture holds a user with the tags work, home and misc and three todos:
+// first is tagged work and home, second is tagged work and untouched is tagged misc.
+type tagFixture struct {
+	client                   *ent.Client
+	service                  TagService
+	userID                   int
+	work, home, misc         int
+	first, second, untouched int
+}
+
+func newTagFixture(t *testing.T) *tagFixture {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	revision.Register(client)
+
+	user := client.User.Create().SetEmail("tags@example.com").SetDisplayName("tags").SetPasswordHash("x").SaveX(ctx)
+	tag := func(name string) int {
+		return client.Tag.Create().SetName(name).SetUserID(user.ID).SaveX(ctx).ID
+	}
+	f := &tagFixture{client: client, service: NewTagService(client), userID: user.ID}
+	f.work, f.home, f.misc = tag("work"), tag("home"), tag("misc")
+	todo := func(title, position string, tagIDs ...int) int {
+		return client.Todo.Create().SetTitle(title).SetPosition(position).SetUserID(user.ID).AddTagIDs(tagIDs...).SaveX(ctx).ID
+	}
+	f.first = todo("first", "a0", f.work, f.home)
+	f.second = todo("second", "a1", f.work)
+	f.untouched = todo("untouched", "a2", f.misc)
+	return f
+}
+
+// history returns the tags recorded by each revision of the todo, oldest first, and its version.
+func (f *tagFixture) history(id int) ([][]string, int) {
+	ctx := context.Background()
+	revisions := f.client.TodoRevision.Query().
+		Where(todorevision.TodoID(id)).
+		Order(todorevision.ByID()).
+		AllX(ctx)
+	tags := make([][]string, len(revisions))
+	for i, r := range revisions {
+		tags[i] = r.Snapshot.Tags
+	}
+	return tags, f.client.Todo.GetX(ctx, id).Version
+}
+
+func (f *tagFixture) assertHistory(t *testing.T, id int, wantTags [][]string, wantVersion int) {
+	t.Helper()
+	tags, version := f.history(id)
+	if !reflect.DeepEqual(tags, wantTags) {
+		t.Errorf("revisions of todo %d recorded tags %v, want %v", id, tags, wantTags)
+	}
+	if version != wantVersion {
+		t.Errorf("version of todo %d = %d, want %d", id, version, wantVersion)
+	}
+}
+
+func TestTagChangesAreRecorded(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rename", func(t *testing.T) {
+		f := newTagFixture(t)
+		if _, err := f.service.RenameTag(ctx, f.userID, f.work, dto.TagForm{Name: "Job"}); err != nil {
+			t.Fatal(err)
+		}
+		f.assertHistory(t, f.first, [][]string{{"home", "work"}, {"home", "job"}}, 2)
+		f.assertHistory(t, f.second, [][]string{{"work"}, {"job"}}, 2)
+		f.assertHistory(t, f.untouched, [][]string{{"misc"}}, 1)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		f := newTagFixture(t)
+		if _, err := f.service.MergeTags(ctx, f.userID, f.work, f.home); err != nil {
+			t.Fatal(err)
+		}
+		f.assertHistory(t, f.first, [][]string{{"home", "work"}, {"home"}}, 2)
+		f.assertHistory(t, f.second, [][]string{{"work"}, {"home"}}, 2)
+		f.assertHistory(t, f.untouched, [][]string{{"misc"}}, 1)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		f := newTagFixture(t)
+		if err := f.service.DeleteTag(ctx, f.userID, f.work); err != nil {
+			t.Fatal(err)
+		}
+		f.assertHistory(t, f.first, [][]string{{"home", "work"}, {"home"}}, 2)
+		f.assertHistory(t, f.second, [][]string{{"work"}, nil}, 2)
+		f.assertHistory(t, f.untouched, [][]string{{"misc"}}, 1)
+	})
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/tag"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+)
+
+// DefaultBulkMaxItems is the batch size limit used when none is configured.
+const DefaultBulkMaxItems = 100
+
+// bulkApply applies the action of a bulk request to a single todo.
+// It returns the IDs of the other todos the action changed as well, e.g. the subtasks
+// trashed together with their parent.
+type bulkApply func(id int) ([]int, error)
+
+func (s *todoService) BulkUpdateTodos(ctx context.Context, userID int, form dto.BulkTodoForm) (*dto.BulkResultDTO, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := s.bulkTargets(ctx, tx.Client(), userID, form)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	apply, err := s.bulkAction(ctx, tx.Client(), userID, form)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	result := &dto.BulkResultDTO{Results: make([]dto.BulkItemResult, 0, len(ids))}
+	// 앞에서 처리한 상위 할 일과 함께 변경된 하위 할 일은 성공으로 봅니다.
+	handled := make(map[int]bool)
+	for _, id := range ids {
+		item := dto.BulkItemResult{ID: id, OK: true}
+		if !handled[id] {
+			cascaded, err := apply(id)
+			var appErr *apperror.Error
+			switch {
+			case errors.As(err, &appErr):
+				item = dto.BulkItemResult{ID: id, ErrorCode: string(appErr.Code), Message: appErr.Message}
+			case err != nil:
+				return nil, rollback(tx, err)
+			}
+			for _, other := range cascaded {
+				handled[other] = true
+			}
+		}
+		if item.OK {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+		result.Results = append(result.Results, item)
+	}
+
+	if form.AllOrNothing && result.Failed > 0 {
+		if err := tx.Rollback(); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	result.Committed = true
+	return result, nil
+}
+
+// bulkTargets resolves the todos of a bulk request, in the order they were given or by ID for a filter.
+// It fails with BULK_LIMIT_EXCEEDED when more todos than the configured limit are selected.
+func (s *todoService) bulkTargets(ctx context.Context, client *ent.Client, userID int, form dto.BulkTodoForm) ([]int, error) {
+	limitErr := apperror.Newf(apperror.CodeBulkLimitExceeded, "A bulk action can change at most %d todos", s.bulkMaxItems)
+
+	if form.Filter == nil {
+		ids := make([]int, 0, len(form.IDs))
+		seen := make(map[int]bool, len(form.IDs))
+		for _, id := range form.IDs {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) > s.bulkMaxItems {
+			return nil, limitErr
+		}
+		return ids, nil
+	}
+
+	predicates, err := todoFilters(s.workflow, form.Filter.ListQuery())
+	if err != nil {
+		return nil, err
+	}
+	scope := todo.DeletedAtIsNil()
+	if form.Action == dto.BulkRestore {
+		scope = todo.DeletedAtNotNil()
+	}
+	// 제한보다 하나 더 읽어서 초과 여부를 확인합니다.
+	ids, err := client.Todo.Query().
+		Where(todo.UserID(userID), scope).
+		Where(predicates...).
+		Order(todo.ByID()).
+		Limit(s.bulkMaxItems + 1).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > s.bulkMaxItems {
+		return nil, limitErr
+	}
+	return ids, nil
+}
+
+// bulkAction checks the parameters of the action once and returns the function applying it to a todo.
+// Errors concerning the whole request, such as an unknown project or tag, are returned here.
+func (s *todoService) bulkAction(ctx context.Context, client *ent.Client, userID int, form dto.BulkTodoForm) (bulkApply, error) {
+	switch form.Action {
+	case dto.BulkSetStatus:
+		return func(id int) ([]int, error) {
+			_, err := s.updateStatus(ctx, client, userID, id, form.Status, nil)
+			return nil, err
+		}, nil
+
+	case dto.BulkDelete:
+		return func(id int) ([]int, error) {
+			return deleteTodo(ctx, client, userID, id, nil)
+		}, nil
+
+	case dto.BulkRestore:
+		return func(id int) ([]int, error) {
+			_, descendants, err := restoreTodo(ctx, client, userID, id)
+			return descendants, err
+		}, nil
+
+	case dto.BulkSetProject:
+		if form.ProjectID != nil {
+			if err := checkProject(ctx, client, userID, *form.ProjectID); err != nil {
+				return nil, err
+			}
+		}
+		return func(id int) ([]int, error) {
+			current, err := bulkTodo(ctx, client, userID, id)
+			if err != nil {
+				return nil, err
+			}
+			// 변경이 없으면 버전이 올라가지 않도록 건너뜁니다.
+			switch {
+			case form.ProjectID == nil && current.ProjectID == nil,
+				form.ProjectID != nil && current.ProjectID != nil && *form.ProjectID == *current.ProjectID:
+				return nil, nil
+			case form.ProjectID == nil:
+				return nil, client.Todo.UpdateOne(current).ClearProjectID().Exec(ctx)
+			default:
+				return nil, client.Todo.UpdateOne(current).SetProjectID(*form.ProjectID).Exec(ctx)
+			}
+		}, nil
+
+	case dto.BulkAddTag:
+		tagIDs, err := ensureTags(ctx, client, userID, []string{form.Tag})
+		if err != nil {
+			return nil, err
+		}
+		if len(tagIDs) == 0 {
+			return nil, apperror.New(apperror.CodeValidationFailed, "tag is required for add_tag")
+		}
+		return func(id int) ([]int, error) {
+			current, tagged, err := bulkTagged(ctx, client, userID, id, tagIDs[0])
+			if err != nil || tagged {
+				return nil, err
+			}
+			return nil, client.Todo.UpdateOne(current).AddTagIDs(tagIDs[0]).Exec(ctx)
+		}, nil
+
+	case dto.BulkRemoveTag:
+		name := normalizeTagName(form.Tag)
+		if name == "" {
+			return nil, apperror.New(apperror.CodeValidationFailed, "tag is required for remove_tag")
+		}
+		tagItem, err := client.Tag.Query().
+			Where(tag.UserID(userID), tag.Name(name)).
+			Only(ctx)
+		if err != nil {
+			return nil, tagError(err, "Tag not found")
+		}
+		return func(id int) ([]int, error) {
+			current, tagged, err := bulkTagged(ctx, client, userID, id, tagItem.ID)
+			if err != nil || !tagged {
+				return nil, err
+			}
+			return nil, client.Todo.UpdateOne(current).RemoveTagIDs(tagItem.ID).Exec(ctx)
+		}, nil
+	}
+	return nil, apperror.Newf(apperror.CodeValidationFailed, "Unknown bulk action %q", form.Action)
+}
+
+// bulkTodo loads a live todo of the user.
+func bulkTodo(ctx context.Context, client *ent.Client, userID, id int) (*ent.Todo, error) {
+	todoItem, err := client.Todo.Query().
+		Where(todo.ID(id), todo.UserID(userID), todo.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, todoError(err, "Todo not found")
+	}
+	return todoItem, nil
+}
+
+// bulkTagged loads a live todo of the user and reports whether it has the tag.
+func bulkTagged(ctx context.Context, client *ent.Client, userID, id, tagID int) (*ent.Todo, bool, error) {
+	todoItem, err := bulkTodo(ctx, client, userID, id)
+	if err != nil {
+		return nil, false, err
+	}
+	tagged, err := todoItem.QueryTags().Where(tag.ID(tagID)).Exist(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return todoItem, tagged, nil
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/rank"
+)
+
+// MaxImportRows is the largest number of todos a single import can create or update.
+const MaxImportRows = 1000
+
+func (s *todoService) ImportTodos(ctx context.Context, userID int, rows []dto.ImportRow, dryRun bool) (*dto.ImportResultDTO, error) {
+	if len(rows) == 0 {
+		return nil, apperror.New(apperror.CodeEmptyBody, "The import contains no todos")
+	}
+	if len(rows) > MaxImportRows {
+		return nil, apperror.Newf(apperror.CodeImportLimitExceeded, "An import can create at most %d todos", MaxImportRows)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 새로 만드는 할 일은 파일의 순서대로 목록의 맨 끝에 추가합니다.
+	position, err := lastPosition(ctx, tx.Client(), userID)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	result := &dto.ImportResultDTO{DryRun: dryRun, Results: make([]dto.ImportRowResult, 0, len(rows))}
+	for _, row := range rows {
+		item := dto.ImportRowResult{Line: row.Line, OK: true}
+		err := row.Err
+		if err == nil {
+			item.Action, item.ID, err = s.importRow(ctx, tx.Client(), userID, row, &position)
+		}
+		var appErr *apperror.Error
+		switch {
+		case errors.As(err, &appErr):
+			item = dto.ImportRowResult{Line: row.Line, ErrorCode: string(appErr.Code), Message: appErr.Message, Errors: appErr.Fields}
+		case err != nil:
+			return nil, rollback(tx, err)
+		}
+		if item.OK {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+		result.Results = append(result.Results, item)
+	}
+
+	if dryRun || result.Failed > 0 {
+		if err := tx.Rollback(); err != nil {
+			return nil, err
+		}
+		// 롤백된 ID는 응답에 포함하지 않습니다.
+		for i := range result.Results {
+			result.Results[i].ID = 0
+		}
+		return result, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	result.Committed = true
+	return result, nil
+}
+
+// importRow updates the todo with the UID of the row or, when there is none, creates a todo after
+// position and advances position to it. It returns the action taken and the ID of the todo.
+func (s *todoService) importRow(ctx context.Context, client *ent.Client, userID int, row dto.ImportRow, position *string) (string, int, error) {
+	current, err := todoByUID(ctx, client, userID, row.UID)
+	if err != nil {
+		return "", 0, err
+	}
+	if current != nil {
+		todoItem, err := s.replaceTodo(ctx, client, userID, current, row.Form)
+		if err != nil {
+			return "", 0, err
+		}
+		return dto.ImportUpdated, todoItem.ID, nil
+	}
+
+	next, ok := rank.After(*position)
+	if !ok {
+		return "", 0, errPositionExhausted
+	}
+	var uid *string
+	if row.UID != "" {
+		uid = &row.UID
+	}
+	todoItem, err := s.insertTodo(ctx, client, userID, row.Form, nil, next, uid)
+	if err != nil {
+		return "", 0, err
+	}
+	*position = next
+	return dto.ImportCreated, todoItem.ID, nil
+}
+
+// todoByUID returns the todo of the user with the given UID, or nil when there is none.
+// Todos without a UID of their own are found by the UID that dto.TodoUID derives from their ID.
+func todoByUID(ctx context.Context, client *ent.Client, userID int, uid string) (*ent.Todo, error) {
+	if uid == "" {
+		return nil, nil
+	}
+	match := todo.UID(uid)
+	if id, ok := dto.LegacyUIDTodoID(uid); ok {
+		match = todo.Or(match, todo.And(todo.ID(id), todo.UIDIsNil()))
+	}
+	current, err := client.Todo.Query().
+		Where(todo.UserID(userID), match).
+		Order(ent.Asc(todo.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// 휴지통의 할 일을 몰래 되살리거나 같은 UID로 또 만들지 않습니다.
+	if current.DeletedAt != nil {
+		return nil, apperror.Newf(apperror.CodeConflict, "Todo %d with this UID is in the trash", current.ID)
+	}
+	return current, nil
+}
@@ -0,0 +1,326 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/predicate"
+	"todo-api-golang/ent/project"
+	"todo-api-golang/ent/tag"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/workflow"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+type sortKind int
+
+const (
+	sortKindInt sortKind = iota
+	sortKindString
+	sortKindTime
+)
+
+// todoSortColumn describes a column that todos can be ordered and paginated by.
+// value returns nil when the column is NULL; nullable columns sort NULLs last in ascending order.
+type todoSortColumn struct {
+	column   string
+	kind     sortKind
+	nullable bool
+	value    func(*ent.Todo) *string
+}
+
+var todoSortColumns = map[string]todoSortColumn{
+	"id": {todo.FieldID, sortKindInt, false, func(t *ent.Todo) *string {
+		return sortValue(strconv.Itoa(t.ID))
+	}},
+	"title": {todo.FieldTitle, sortKindString, false, func(t *ent.Todo) *string {
+		return sortValue(t.Title)
+	}},
+	"created_at": {todo.FieldCreatedAt, sortKindTime, false, func(t *ent.Todo) *string {
+		return sortValue(t.CreatedAt.Format(time.RFC3339Nano))
+	}},
+	"updated_at": {todo.FieldUpdatedAt, sortKindTime, false, func(t *ent.Todo) *string {
+		return sortValue(t.UpdatedAt.Format(time.RFC3339Nano))
+	}},
+	"priority": {todo.FieldPriority, sortKindInt, false, func(t *ent.Todo) *string {
+		return sortValue(strconv.Itoa(t.Priority))
+	}},
+	"position": {todo.FieldPosition, sortKindString, false, func(t *ent.Todo) *string {
+		return sortValue(t.Position)
+	}},
+	"due_at": {todo.FieldDueAt, sortKindTime, true, func(t *ent.Todo) *string {
+		if t.DueAt == nil {
+			return nil
+		}
+		return sortValue(t.DueAt.Format(time.RFC3339Nano))
+	}},
+}
+
+func sortValue(v string) *string {
+	return &v
+}
+
+// todoCursor is the decoded form of the opaque pagination cursor.
+// Sort records the ordering the cursor was created with so it cannot be reused with another one.
+type todoCursor struct {
+	Sort   string    `json:"s"`
+	Values []*string `json:"v"`
+}
+
+// normalizeTodoSort validates the requested ordering and appends id as a tie-breaker,
+// so that every ordering is total and can be used for keyset pagination.
+func normalizeTodoSort(fields []dto.SortField) ([]dto.SortField, error) {
+	normalized := make([]dto.SortField, 0, len(fields)+1)
+	seen := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if _, ok := todoSortColumns[f.Field]; !ok {
+			return nil, apperror.Newf(apperror.CodeInvalidQuery, "Cannot sort by %q", f.Field)
+		}
+		if seen[f.Field] {
+			return nil, apperror.Newf(apperror.CodeInvalidQuery, "Duplicate sort field %q", f.Field)
+		}
+		seen[f.Field] = true
+		normalized = append(normalized, f)
+	}
+	if !seen["id"] {
+		normalized = append(normalized, dto.SortField{Field: "id"})
+	}
+	return normalized, nil
+}
+
+func sortSignature(fields []dto.SortField) string {
+	terms := make([]string, len(fields))
+	for i, f := range fields {
+		if f.Desc {
+			terms[i] = "-" + f.Field
+		} else {
+			terms[i] = f.Field
+		}
+	}
+	return strings.Join(terms, ",")
+}
+
+func todoOrder(fields []dto.SortField) []todo.OrderOption {
+	orders := make([]todo.OrderOption, 0, len(fields))
+	for _, f := range fields {
+		col := todoSortColumns[f.Field]
+		if col.nullable {
+			// NULL은 오름차순에서 마지막, 내림차순에서 처음에 오도록 정렬합니다.
+			orders = append(orders, func(s *sql.Selector) {
+				expr := s.C(col.column) + " IS NULL"
+				if f.Desc {
+					expr += " DESC"
+				}
+				s.OrderExpr(sql.Expr(expr))
+			})
+		}
+		opts := []sql.OrderTermOption{sql.OrderAsc()}
+		if f.Desc {
+			opts = []sql.OrderTermOption{sql.OrderDesc()}
+		}
+		orders = append(orders, sql.OrderByField(col.column, opts...).ToFunc())
+	}
+	return orders
+}
+
+func encodeTodoCursor(fields []dto.SortField, last *ent.Todo) string {
+	cursor := todoCursor{
+		Sort:   sortSignature(fields),
+		Values: make([]*string, len(fields)),
+	}
+	for i, f := range fields {
+		cursor.Values[i] = todoSortColumns[f.Field].value(last)
+	}
+	raw, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeTodoCursor returns the typed sort values stored in the cursor.
+func decodeTodoCursor(fields []dto.SortField, encoded string) ([]any, error) {
+	invalid := apperror.New(apperror.CodeInvalidQuery, "Malformed cursor")
+
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, invalid
+	}
+	var cursor todoCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, invalid
+	}
+	if cursor.Sort != sortSignature(fields) || len(cursor.Values) != len(fields) {
+		return nil, apperror.New(apperror.CodeInvalidQuery, "Cursor does not match the requested sort")
+	}
+
+	values := make([]any, len(fields))
+	for i, f := range fields {
+		col := todoSortColumns[f.Field]
+		raw := cursor.Values[i]
+		if raw == nil {
+			if !col.nullable {
+				return nil, invalid
+			}
+			continue
+		}
+		switch col.kind {
+		case sortKindInt:
+			v, err := strconv.Atoi(*raw)
+			if err != nil {
+				return nil, invalid
+			}
+			values[i] = v
+		case sortKindTime:
+			v, err := time.Parse(time.RFC3339Nano, *raw)
+			if err != nil {
+				return nil, invalid
+			}
+			values[i] = v
+		default:
+			values[i] = *raw
+		}
+	}
+	return values, nil
+}
+
+// todosAfter matches the rows that come after the cursor position in the given ordering:
+// (a > va) OR (a = va AND b > vb) OR ...
+// A nil value means the cursor row had NULL in that column.
+func todosAfter(fields []dto.SortField, values []any) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		ors := make([]*sql.Predicate, len(fields))
+		for i, f := range fields {
+			ands := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				ands = append(ands, sortEQ(s.C(todoSortColumns[fields[j].Field].column), values[j]))
+			}
+			ands = append(ands, sortAfter(s.C(todoSortColumns[f.Field].column), f.Desc, values[i]))
+			ors[i] = sql.And(ands...)
+		}
+		s.Where(sql.Or(ors...))
+	})
+}
+
+func sortEQ(column string, value any) *sql.Predicate {
+	if value == nil {
+		return sql.IsNull(column)
+	}
+	return sql.EQ(column, value)
+}
+
+// sortAfter matches the values that sort strictly after value.
+// NULLs sort last in ascending and first in descending order.
+func sortAfter(column string, desc bool, value any) *sql.Predicate {
+	switch {
+	case value == nil && desc:
+		return sql.NotNull(column)
+	case value == nil:
+		return sql.False()
+	case desc:
+		return sql.LT(column, value)
+	default:
+		return sql.Or(sql.GT(column, value), sql.IsNull(column))
+	}
+}
+
+// todoFilters converts the list query filters into ent predicates.
+// Statuses are checked against the states of the workflow.
+func todoFilters(wf *workflow.Workflow, q dto.TodoListQuery) ([]predicate.Todo, error) {
+	var predicates []predicate.Todo
+
+	if len(q.Statuses) > 0 {
+		for _, st := range q.Statuses {
+			if !wf.IsState(st) {
+				return nil, apperror.Newf(apperror.CodeInvalidQuery, "Unknown status %q", st)
+			}
+		}
+		predicates = append(predicates, todo.StatusIn(q.Statuses...))
+	}
+	if q.Search != "" {
+		predicates = append(predicates, todo.Or(
+			todo.TitleContainsFold(q.Search),
+			todo.DescriptionContainsFold(q.Search),
+		))
+	}
+	if len(q.Tags) > 0 {
+		names := make([]string, len(q.Tags))
+		for i, name := range q.Tags {
+			names[i] = normalizeTagName(name)
+		}
+		switch q.TagMode {
+		case "", dto.TagMatchAny:
+			predicates = append(predicates, todo.HasTagsWith(tag.NameIn(names...)))
+		case dto.TagMatchAll:
+			for _, name := range names {
+				predicates = append(predicates, todo.HasTagsWith(tag.Name(name)))
+			}
+		default:
+			return nil, apperror.Newf(apperror.CodeInvalidQuery, "Unknown tag mode %q", q.TagMode)
+		}
+	}
+	if q.RootsOnly {
+		predicates = append(predicates, todo.ParentIDIsNil())
+	}
+	switch {
+	case q.NoProject:
+		predicates = append(predicates, todo.ProjectIDIsNil())
+	case q.ProjectID != nil:
+		predicates = append(predicates, todo.ProjectID(*q.ProjectID))
+	case !q.IncludeArchived:
+		predicates = append(predicates, todo.Or(
+			todo.ProjectIDIsNil(),
+			todo.HasProjectWith(project.Archived(false)),
+		))
+	}
+	if q.Due != "" {
+		p, err := dueFilter(q.Due, q.Location, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	if q.CreatedAfter != nil {
+		predicates = append(predicates, todo.CreatedAtGT(*q.CreatedAfter))
+	}
+	if q.CreatedBefore != nil {
+		predicates = append(predicates, todo.CreatedAtLT(*q.CreatedBefore))
+	}
+	if q.UpdatedAfter != nil {
+		predicates = append(predicates, todo.UpdatedAtGT(*q.UpdatedAfter))
+	}
+	if q.UpdatedBefore != nil {
+		predicates = append(predicates, todo.UpdatedAtLT(*q.UpdatedBefore))
+	}
+	return predicates, nil
+}
+
+// dueFilter matches the todos that fall into the given due window.
+// Day and week boundaries are computed in loc; weeks start on Monday.
+func dueFilter(due string, loc *time.Location, now time.Time) (predicate.Todo, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	now = now.In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	switch due {
+	case dto.DueOverdue:
+		return todo.And(todo.DueAtLT(now), todo.CompletedAtIsNil()), nil
+	case dto.DueToday:
+		return todo.And(todo.DueAtGTE(today), todo.DueAtLT(today.AddDate(0, 0, 1))), nil
+	case dto.DueThisWeek:
+		monday := today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+		return todo.And(todo.DueAtGTE(monday), todo.DueAtLT(monday.AddDate(0, 0, 7))), nil
+	default:
+		return nil, apperror.Newf(apperror.CodeInvalidQuery, "Unknown due filter %q", due)
+	}
+}
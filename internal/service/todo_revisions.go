@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"time"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/todorevision"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+)
+
+func (s *todoService) ListTodoHistory(ctx context.Context, userID, id int) ([]dto.TodoRevisionDTO, error) {
+	todoItem, err := s.client.Todo.Query().
+		Where(todo.ID(id), todo.UserID(userID), todo.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, todoError(err, "Todo not found")
+	}
+	revisions, err := s.client.Todo.QueryRevisions(todoItem).
+		Order(todorevision.ByCreatedAt(), todorevision.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revisionDTOs := make([]dto.TodoRevisionDTO, len(revisions))
+	for i, r := range revisions {
+		revisionDTOs[i] = dto.ConvertTodoRevisionToDTO(r)
+	}
+	return revisionDTOs, nil
+}
+
+func (s *todoService) RevertTodo(ctx context.Context, userID, id, revisionID int) (*dto.TodoDTO, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, err := tx.Todo.Query().
+		Where(todo.ID(id), todo.UserID(userID), todo.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, todoError(err, "Todo not found"))
+	}
+	revisionItem, err := tx.TodoRevision.Query().
+		Where(todorevision.ID(revisionID), todorevision.TodoID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = apperror.Wrap(err, apperror.CodeRevisionNotFound, "Revision not found")
+		}
+		return nil, rollback(tx, err)
+	}
+	snapshot := revisionItem.Snapshot
+
+	// 되돌리는 변경도 일반 수정과 같은 검사를 거칩니다. 위치, 상위 할 일과 휴지통 상태는 되돌리지 않습니다.
+	if snapshot.ProjectID != nil && (current.ProjectID == nil || *current.ProjectID != *snapshot.ProjectID) {
+		if err := checkProject(ctx, tx.Client(), userID, *snapshot.ProjectID); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	tagIDs, err := ensureTags(ctx, tx.Client(), userID, snapshot.Tags)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	update := tx.Todo.UpdateOne(current).
+		SetTitle(snapshot.Title).
+		SetDescription(snapshot.Description).
+		SetPriority(snapshot.Priority).
+		SetAutoComplete(snapshot.AutoComplete).
+		ClearTags().
+		AddTagIDs(tagIDs...)
+	if snapshot.ProjectID != nil {
+		update.SetProjectID(*snapshot.ProjectID)
+	} else {
+		update.ClearProjectID()
+	}
+	if snapshot.DueAt != nil {
+		update.SetDueAt(*snapshot.DueAt)
+	} else {
+		update.ClearDueAt()
+	}
+	if snapshot.RemindAt != nil {
+		update.SetRemindAt(*snapshot.RemindAt)
+		if snapshot.RemindAt.After(time.Now()) {
+			update.ClearRemindedAt()
+		}
+	} else {
+		update.ClearRemindAt().ClearRemindedAt()
+	}
+	transition, err := s.workflow.Apply(update.Mutation(), current, snapshot.Status, time.Now())
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	todoItem, err := update.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := recordTransition(ctx, tx.Client(), todoItem.ID, transition); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := s.completeParents(ctx, tx.Client(), todoItem); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return s.todoDTO(ctx, todoItem)
+}
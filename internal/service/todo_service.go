@@ -4,103 +4,757 @@ import (
 	"context"
 	"time"
 	"todo-api-golang/ent"
+	"todo-api-golang/ent/predicate"
+	"todo-api-golang/ent/project"
+	"todo-api-golang/ent/tag"
 	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/user"
+	"todo-api-golang/internal/apperror"
 	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/rank"
+	"todo-api-golang/internal/workflow"
+	"todo-api-golang/util"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+var (
+	// errPositionExhausted is returned when no position key is left between two neighbours.
+	errPositionExhausted = apperror.New(apperror.CodeConflict, "No position left between the neighbouring todos")
+	// errVersionMismatch is returned when the todo was changed since the client read it.
+	errVersionMismatch = apperror.New(apperror.CodePreconditionFailed, "The todo was changed by another request")
 )
 
 // TodoService defines the interface for Todo operations and implements it.
+// Every method is scoped to the todos owned by the given user,
+// and soft-deleted todos are only visible through the trash methods.
+// Methods taking a version fail with PRECONDITION_FAILED when it is not nil
+// and the todo is no longer at that version.
 type TodoService interface {
-	CreateTodo(ctx context.Context, form dto.TodoForm) (*dto.TodoDTO, error)
-	GetTodoByID(ctx context.Context, id int) (*dto.TodoDTO, error)
-	UpdateTodo(ctx context.Context, id int, form dto.TodoForm) (*dto.TodoDTO, error)
-	UpdateTodoStatus(ctx context.Context, id int, status string) (*dto.TodoDTO, error)
-	DeleteTodo(ctx context.Context, id int) error
-	ListTodos(ctx context.Context) ([]dto.TodoDTO, error)
+	CreateTodo(ctx context.Context, userID int, form dto.TodoForm) (*dto.TodoDTO, error)
+	GetTodoByID(ctx context.Context, userID, id int) (*dto.TodoDTO, error)
+	UpdateTodo(ctx context.Context, userID, id int, form dto.TodoForm, version *int) (*dto.TodoDTO, error)
+	// UpdateTodoStatus moves a todo to another state; the workflow decides which changes are allowed.
+	UpdateTodoStatus(ctx context.Context, userID, id int, status string, version *int) (*dto.TodoDTO, error)
+	// ListTodoTransitions returns the status changes of a todo, oldest first.
+	ListTodoTransitions(ctx context.Context, userID, id int) ([]dto.TodoTransitionDTO, error)
+	// ListTodoHistory returns the recorded revisions of a todo, oldest first.
+	ListTodoHistory(ctx context.Context, userID, id int) ([]dto.TodoRevisionDTO, error)
+	// RevertTodo restores the fields of a todo to the state recorded by one of its revisions.
+	// The position, the parent and the trash state are left as they are.
+	RevertTodo(ctx context.Context, userID, id, revisionID int) (*dto.TodoDTO, error)
+	// GetWorkflow describes the states and transitions of the workflow.
+	GetWorkflow() dto.WorkflowDTO
+	// CreateSubtask creates a todo under the parent todo; it inherits the parent's project unless one is given.
+	CreateSubtask(ctx context.Context, userID, parentID int, form dto.TodoForm) (*dto.TodoDTO, error)
+	// ListSubtasks returns the direct subtasks of a todo in their manual order.
+	ListSubtasks(ctx context.Context, userID, parentID int) ([]dto.TodoDTO, error)
+	// SetTodoParent moves a todo under another todo, or to the top level when parentID is nil.
+	SetTodoParent(ctx context.Context, userID, id int, parentID *int) (*dto.TodoDTO, error)
+	// MoveTodo changes the manual position of a todo without renumbering the other todos.
+	MoveTodo(ctx context.Context, userID, id int, form dto.MoveTodoForm) (*dto.TodoDTO, error)
+	DeleteTodo(ctx context.Context, userID, id int, version *int) error
+	ListTodos(ctx context.Context, userID int, query dto.TodoListQuery) (*dto.TodoPage, error)
+	ListTrash(ctx context.Context, userID int, query dto.TodoListQuery) (*dto.TodoPage, error)
+	RestoreTodo(ctx context.Context, userID, id int) (*dto.TodoDTO, error)
+	PurgeTodo(ctx context.Context, userID, id int) error
+	// PurgeExpiredTrash permanently deletes the todos of every user that were soft-deleted before the given time.
+	PurgeExpiredTrash(ctx context.Context, before time.Time) (int, error)
+	// BulkUpdateTodos applies one action to many todos in a single transaction and reports
+	// the outcome per todo. Todos the action fails for are skipped unless AllOrNothing is set,
+	// in which case the whole transaction is rolled back.
+	BulkUpdateTodos(ctx context.Context, userID int, form dto.BulkTodoForm) (*dto.BulkResultDTO, error)
+	// ImportTodos creates a todo for every row at the end of the user's list in a single transaction
+	// and reports the outcome per row. A row with the UID of a todo of the user updates that todo instead.
+	// Nothing is changed unless every row succeeds; with dryRun the rows are checked the same way
+	// and the transaction is always rolled back.
+	ImportTodos(ctx context.Context, userID int, rows []dto.ImportRow, dryRun bool) (*dto.ImportResultDTO, error)
+	// ClaimDueReminders marks up to limit todos whose remind_at has passed as reminded and returns them.
+	// Each reminder is returned only once, also when several instances claim reminders at the same time.
+	ClaimDueReminders(ctx context.Context, now time.Time, limit int) ([]dto.Reminder, error)
 }
 
 // todoService is the concrete implementation of TodoService.
 type todoService struct {
-	client *ent.Client
+	client       *ent.Client
+	workflow     *workflow.Workflow
+	bulkMaxItems int
 }
 
 // NewTodoService creates a new instance of todoService.
-func NewTodoService(client *ent.Client) TodoService {
-	return &todoService{client: client}
+// Status changes follow the given workflow; config.BulkMaxItems limits the size of bulk actions.
+func NewTodoService(client *ent.Client, wf *workflow.Workflow, config util.Config) TodoService {
+	bulkMaxItems := config.BulkMaxItems
+	if bulkMaxItems <= 0 {
+		bulkMaxItems = DefaultBulkMaxItems
+	}
+	return &todoService{client: client, workflow: wf, bulkMaxItems: bulkMaxItems}
 }
 
 // Implement the methods defined in the TodoService interface.
 
-func (s *todoService) CreateTodo(ctx context.Context, form dto.TodoForm) (*dto.TodoDTO, error) {
-	todoItem, err := s.client.Todo.Create().
+func (s *todoService) CreateTodo(ctx context.Context, userID int, form dto.TodoForm) (*dto.TodoDTO, error) {
+	return s.createTodo(ctx, userID, form, nil)
+}
+
+// createTodo creates a todo at the end of the user's list, under parentID when it is not nil.
+// A subtask inherits the project of its parent unless the form sets one.
+func (s *todoService) createTodo(ctx context.Context, userID int, form dto.TodoForm, parentID *int) (*dto.TodoDTO, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 새 할 일은 목록의 맨 끝에 추가합니다.
+	position, err := lastPosition(ctx, tx.Client(), userID)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	position, ok := rank.After(position)
+	if !ok {
+		return nil, rollback(tx, errPositionExhausted)
+	}
+	if parentID != nil {
+		// 잠금 뒤에 확인하므로 동시에 트리를 바꾸는 요청이 깊이 제한을 넘기지 못합니다.
+		parent, err := subtaskParent(ctx, tx.Client(), userID, *parentID)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		if form.ProjectID == nil {
+			form.ProjectID = parent.ProjectID
+		}
+	}
+	todoItem, err := s.insertTodo(ctx, tx.Client(), userID, form, parentID, position, nil)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return s.todoDTO(ctx, todoItem)
+}
+
+// insertTodo creates a todo at position with the client of a transaction and records its initial transition.
+func (s *todoService) insertTodo(ctx context.Context, client *ent.Client, userID int, form dto.TodoForm, parentID *int, position string, uid *string) (*ent.Todo, error) {
+	priority, err := todoPriority(form.Priority)
+	if err != nil {
+		return nil, err
+	}
+	if form.ProjectID != nil {
+		if err := checkProject(ctx, client, userID, *form.ProjectID); err != nil {
+			return nil, err
+		}
+	}
+	tagIDs, err := ensureTags(ctx, client, userID, form.Tags)
+	if err != nil {
+		return nil, err
+	}
+	create := client.Todo.Create().
 		SetTitle(form.Title).
 		SetDescription(form.Description).
-		SetStatus(todo.Status(form.Status)).
-		Save(ctx)
+		SetPriority(priority).
+		SetPosition(position).
+		SetNillableProjectID(form.ProjectID).
+		SetNillableParentID(parentID).
+		SetAutoComplete(form.AutoComplete).
+		SetNillableDueAt(form.DueAt).
+		SetNillableRemindAt(form.RemindAt).
+		SetUserID(userID).
+		SetNillableUID(uid).
+		AddTagIDs(tagIDs...)
+	transition, err := s.workflow.Apply(create.Mutation(), nil, form.Status, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	todoItem, err := create.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if err := recordTransition(ctx, client, todoItem.ID, transition); err != nil {
+		return nil, err
+	}
+	return todoItem, nil
+}
+
+func (s *todoService) GetTodoByID(ctx context.Context, userID, id int) (*dto.TodoDTO, error) {
+	todoItem, err := s.client.Todo.Query().
+		Where(todo.ID(id), todo.UserID(userID), todo.DeletedAtIsNil()).
+		WithTags(orderTags).
+		WithChildren(liveChildren).
+		Only(ctx)
+	if err != nil {
+		return nil, todoError(err, "Todo not found")
+	}
 	todoDTO := dto.ConvertTodoToDTO(todoItem)
 	return &todoDTO, nil
 }
 
-func (s *todoService) GetTodoByID(ctx context.Context, id int) (*dto.TodoDTO, error) {
-	todoItem, err := s.client.Todo.Get(ctx, id)
+func (s *todoService) UpdateTodo(ctx context.Context, userID, id int, form dto.TodoForm, version *int) (*dto.TodoDTO, error) {
+	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		return nil, err
 	}
-	todoDTO := dto.ConvertTodoToDTO(todoItem)
-	return &todoDTO, nil
+	current, err := tx.Todo.Query().
+		Where(todo.ID(id), todo.UserID(userID), todo.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, todoError(err, "Todo not found"))
+	}
+	if err := checkVersion(current, version); err != nil {
+		return nil, rollback(tx, err)
+	}
+	todoItem, err := s.replaceTodo(ctx, tx.Client(), userID, current, form)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return s.todoDTO(ctx, todoItem)
 }
 
-func (s *todoService) UpdateTodo(ctx context.Context, id int, form dto.TodoForm) (*dto.TodoDTO, error) {
-	todoItem, err := s.client.Todo.Get(ctx, id)
+// replaceTodo sets the fields of the current todo to the form with the client of a transaction.
+// The update fails with PRECONDITION_FAILED when the todo was changed since current was read.
+func (s *todoService) replaceTodo(ctx context.Context, client *ent.Client, userID int, current *ent.Todo, form dto.TodoForm) (*ent.Todo, error) {
+	priority, err := todoPriority(form.Priority)
 	if err != nil {
 		return nil, err
 	}
-	_, err = todoItem.Update().
+	// 이미 속한 프로젝트는 보관되었더라도 그대로 둘 수 있습니다.
+	if form.ProjectID != nil && (current.ProjectID == nil || *current.ProjectID != *form.ProjectID) {
+		if err := checkProject(ctx, client, userID, *form.ProjectID); err != nil {
+			return nil, err
+		}
+	}
+	tagIDs, err := ensureTags(ctx, client, userID, form.Tags)
+	if err != nil {
+		return nil, err
+	}
+	update := client.Todo.UpdateOne(current).
+		Where(todo.Version(current.Version)).
 		SetTitle(form.Title).
 		SetDescription(form.Description).
-		SetStatus(todo.Status(form.Status)).
-		Save(ctx)
+		SetPriority(priority).
+		SetAutoComplete(form.AutoComplete).
+		ClearTags().
+		AddTagIDs(tagIDs...)
+	if form.ProjectID != nil {
+		update.SetProjectID(*form.ProjectID)
+	} else {
+		update.ClearProjectID()
+	}
+	if form.DueAt != nil {
+		update.SetDueAt(*form.DueAt)
+	} else {
+		update.ClearDueAt()
+	}
+	if form.RemindAt != nil {
+		update.SetRemindAt(*form.RemindAt)
+		// 미래 시각으로 다시 설정된 알림은 다시 발송되어야 합니다.
+		if form.RemindAt.After(time.Now()) {
+			update.ClearRemindedAt()
+		}
+	} else {
+		update.ClearRemindAt().ClearRemindedAt()
+	}
+	transition, err := s.workflow.Apply(update.Mutation(), current, form.Status, time.Now())
 	if err != nil {
 		return nil, err
 	}
-	todoDTO := dto.ConvertTodoToDTO(todoItem)
-	return &todoDTO, nil
+	todoItem, err := update.Save(ctx)
+	if err != nil {
+		return nil, versionError(err)
+	}
+	if err := recordTransition(ctx, client, todoItem.ID, transition); err != nil {
+		return nil, err
+	}
+	if err := s.completeParents(ctx, client, todoItem); err != nil {
+		return nil, err
+	}
+	return todoItem, nil
 }
 
-func (s *todoService) UpdateTodoStatus(ctx context.Context, id int, status string) (*dto.TodoDTO, error) {
-	todoItem, err := s.client.Todo.Get(ctx, id)
+func (s *todoService) UpdateTodoStatus(ctx context.Context, userID, id int, status string, version *int) (*dto.TodoDTO, error) {
+	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		return nil, err
 	}
-	_, err = todoItem.Update().
-		SetStatus(todo.Status(status)).
-		Save(ctx)
+	todoItem, err := s.updateStatus(ctx, tx.Client(), userID, id, status, version)
 	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
-	todoDTO := dto.ConvertTodoToDTO(todoItem)
-	return &todoDTO, nil
+	return s.todoDTO(ctx, todoItem)
 }
 
-func (s *todoService) DeleteTodo(ctx context.Context, id int) error {
-	_, err := s.client.Todo.UpdateOneID(id).
-		SetDeletedAt(time.Now()).
-		Save(ctx)
-	return err
+// updateStatus changes the status of a todo with the transactional client.
+// The workflow and version checks are done before anything is written.
+func (s *todoService) updateStatus(ctx context.Context, client *ent.Client, userID, id int, status string, version *int) (*ent.Todo, error) {
+	current, err := client.Todo.Query().
+		Where(todo.ID(id), todo.UserID(userID), todo.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, todoError(err, "Todo not found")
+	}
+	if err := checkVersion(current, version); err != nil {
+		return nil, err
+	}
+	update := client.Todo.UpdateOne(current).Where(todo.Version(current.Version))
+	transition, err := s.workflow.Apply(update.Mutation(), current, status, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	todoItem, err := update.Save(ctx)
+	if err != nil {
+		return nil, versionError(err)
+	}
+	if err := recordTransition(ctx, client, todoItem.ID, transition); err != nil {
+		return nil, err
+	}
+	if err := s.completeParents(ctx, client, todoItem); err != nil {
+		return nil, err
+	}
+	return todoItem, nil
+}
+
+func (s *todoService) MoveTodo(ctx context.Context, userID, id int, form dto.MoveTodoForm) (*dto.TodoDTO, error) {
+	targetID, before := 0, form.BeforeID != nil
+	switch {
+	case form.BeforeID != nil:
+		targetID = *form.BeforeID
+	case form.AfterID != nil:
+		targetID = *form.AfterID
+	default:
+		return nil, apperror.New(apperror.CodeBadRequest, "Either before_id or after_id is required")
+	}
+	if targetID == id {
+		return nil, apperror.New(apperror.CodeBadRequest, "Cannot move a todo relative to itself")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := lockUserTodos(ctx, tx.Client(), userID); err != nil {
+		return nil, rollback(tx, err)
+	}
+	live := []predicate.Todo{todo.UserID(userID), todo.DeletedAtIsNil()}
+
+	if _, err := tx.Todo.Query().Where(todo.ID(id)).Where(live...).OnlyID(ctx); err != nil {
+		return nil, rollback(tx, todoError(err, "Todo not found"))
+	}
+	target, err := tx.Todo.Query().Where(todo.ID(targetID)).Where(live...).Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, todoError(err, "Target todo not found"))
+	}
+
+	// 대상과 그 이웃 사이의 키를 만들어 이동하는 할 일만 갱신합니다.
+	neighbours := tx.Todo.Query().Where(live...).Where(todo.IDNEQ(id))
+	var lower, upper string
+	if before {
+		upper = target.Position
+		prev, err := neighbours.Where(todo.PositionLT(upper)).Order(todo.ByPosition(sql.OrderDesc())).First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, rollback(tx, err)
+		}
+		if prev != nil {
+			lower = prev.Position
+		}
+	} else {
+		lower = target.Position
+		next, err := neighbours.Where(todo.PositionGT(lower)).Order(todo.ByPosition()).First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, rollback(tx, err)
+		}
+		if next != nil {
+			upper = next.Position
+		}
+	}
+	position, ok := rank.Between(lower, upper)
+	if !ok {
+		return nil, rollback(tx, errPositionExhausted)
+	}
+
+	todoItem, err := tx.Todo.UpdateOneID(id).SetPosition(position).Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return s.todoDTO(ctx, todoItem)
+}
+
+func (s *todoService) DeleteTodo(ctx context.Context, userID, id int, version *int) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := deleteTodo(ctx, tx.Client(), userID, id, version); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+// deleteTodo moves a todo and its subtasks to the trash with the transactional client
+// and returns the IDs of the subtasks.
+func deleteTodo(ctx context.Context, client *ent.Client, userID, id int, version *int) ([]int, error) {
+	current, err := client.Todo.Query().
+		Where(todo.ID(id), todo.UserID(userID), todo.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, todoError(err, "Todo not found")
+	}
+	if err := checkVersion(current, version); err != nil {
+		return nil, err
+	}
+	// 하위 할 일도 같은 시각으로 휴지통에 넣어 함께 복원할 수 있게 합니다.
+	now := time.Now()
+	err = client.Todo.UpdateOne(current).
+		Where(todo.Version(current.Version)).
+		SetDeletedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return nil, versionError(err)
+	}
+	descendants, err := descendantIDs(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+	err = client.Todo.Update().
+		Where(todo.IDIn(descendants...), todo.DeletedAtIsNil()).
+		SetDeletedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return descendants, nil
+}
+
+func (s *todoService) ListTodos(ctx context.Context, userID int, query dto.TodoListQuery) (*dto.TodoPage, error) {
+	return s.listTodos(ctx, query, todo.UserID(userID), todo.DeletedAtIsNil())
+}
+
+func (s *todoService) ListTrash(ctx context.Context, userID int, query dto.TodoListQuery) (*dto.TodoPage, error) {
+	return s.listTodos(ctx, query, todo.UserID(userID), todo.DeletedAtNotNil())
+}
+
+func (s *todoService) RestoreTodo(ctx context.Context, userID, id int) (*dto.TodoDTO, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	todoItem, _, err := restoreTodo(ctx, tx.Client(), userID, id)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return s.todoDTO(ctx, todoItem)
 }
 
-func (s *todoService) ListTodos(ctx context.Context) ([]dto.TodoDTO, error) {
+// restoreTodo restores a todo and the subtasks trashed together with it using the
+// transactional client. It returns the restored todo and the IDs of its subtasks.
+func restoreTodo(ctx context.Context, client *ent.Client, userID, id int) (*ent.Todo, []int, error) {
+	trashed, err := client.Todo.Query().
+		Where(todo.ID(id), todo.UserID(userID), todo.DeletedAtNotNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, nil, todoError(err, "Todo not found in trash")
+	}
+	descendants, err := descendantIDs(ctx, client, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = client.Todo.Update().
+		Where(todo.IDIn(descendants...), todo.DeletedAt(*trashed.DeletedAt)).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	update := client.Todo.UpdateOne(trashed).ClearDeletedAt()
+	// 프로젝트나 상위 할 일이 휴지통에 있으면 그 연결 없이 복원합니다.
+	if trashed.ProjectID != nil {
+		trashedProject, err := client.Project.Query().
+			Where(project.ID(*trashed.ProjectID), project.DeletedAtNotNil()).
+			Exist(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if trashedProject {
+			update.ClearProjectID()
+		}
+	}
+	if trashed.ParentID != nil {
+		trashedParent, err := client.Todo.Query().
+			Where(todo.ID(*trashed.ParentID), todo.DeletedAtNotNil()).
+			Exist(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if trashedParent {
+			update.ClearParentID()
+		}
+	}
+	todoItem, err := update.Save(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return todoItem, descendants, nil
+}
+
+func (s *todoService) PurgeTodo(ctx context.Context, userID, id int) error {
+	err := s.client.Todo.DeleteOneID(id).
+		Where(todo.UserID(userID), todo.DeletedAtNotNil()).
+		Exec(ctx)
+	return todoError(err, "Todo not found in trash")
+}
+
+func (s *todoService) PurgeExpiredTrash(ctx context.Context, before time.Time) (int, error) {
+	return s.client.Todo.Delete().
+		Where(todo.DeletedAtLT(before)).
+		Exec(ctx)
+}
+
+func (s *todoService) ClaimDueReminders(ctx context.Context, now time.Time, limit int) ([]dto.Reminder, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	due, err := tx.Todo.Query().
+		Where(
+			todo.RemindAtLTE(now),
+			todo.RemindedAtIsNil(),
+			todo.DeletedAtIsNil(),
+			skipLocked,
+		).
+		Order(todo.ByRemindAt(), todo.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if len(due) == 0 {
+		return nil, rollback(tx, nil)
+	}
+
+	ids := make([]int, len(due))
+	for i, t := range due {
+		ids[i] = t.ID
+	}
+	if err := tx.Todo.Update().Where(todo.IDIn(ids...), todo.RemindedAtIsNil()).SetRemindedAt(now).Exec(ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	reminders := make([]dto.Reminder, len(due))
+	for i, t := range due {
+		reminders[i] = dto.Reminder{UserID: t.UserID, Todo: dto.ConvertTodoToDTO(t)}
+	}
+	return reminders, nil
+}
+
+// listTodos returns a page of the todos matching scope and the list query.
+func (s *todoService) listTodos(ctx context.Context, query dto.TodoListQuery, scope ...predicate.Todo) (*dto.TodoPage, error) {
+	predicates, err := todoFilters(s.workflow, query)
+	if err != nil {
+		return nil, err
+	}
+	sortFields, err := normalizeTodoSort(query.Sort)
+	if err != nil {
+		return nil, err
+	}
+	if query.Cursor != "" {
+		values, err := decodeTodoCursor(sortFields, query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, todosAfter(sortFields, values))
+	}
+	limit := query.Limit
+	if limit <= 0 || limit > MaxListLimit {
+		limit = DefaultListLimit
+	}
+
+	// 다음 페이지가 있는지 확인하기 위해 한 건을 더 조회합니다.
 	todos, err := s.client.Todo.Query().
-		Where(todo.DeletedAtIsNil()).
+		Where(scope...).
+		Where(predicates...).
+		Order(todoOrder(sortFields)...).
+		WithTags(orderTags).
+		WithChildren(liveChildren).
+		Limit(limit + 1).
 		All(ctx)
 	if err != nil {
 		return nil, err
 	}
-	todoDTOs := make([]dto.TodoDTO, len(todos))
+
+	page := &dto.TodoPage{}
+	if len(todos) > limit {
+		todos = todos[:limit]
+		page.NextCursor = encodeTodoCursor(sortFields, todos[len(todos)-1])
+	}
+	page.Todos = make([]dto.TodoDTO, len(todos))
+	page.Cursors = make([]string, len(todos))
 	for i, t := range todos {
-		todoDTOs[i] = dto.ConvertTodoToDTO(t)
+		page.Todos[i] = dto.ConvertTodoToDTO(t)
+		page.Cursors[i] = encodeTodoCursor(sortFields, t)
+	}
+	return page, nil
+}
+
+// todoDTO loads the tags and subtasks of a todo returned by a create or update and converts it.
+func (s *todoService) todoDTO(ctx context.Context, todoItem *ent.Todo) (*dto.TodoDTO, error) {
+	tags, err := s.client.Todo.QueryTags(todoItem).Order(tag.ByName()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	todoItem.Edges.Tags = tags
+	children, err := s.client.Todo.QueryChildren(todoItem).Where(todo.DeletedAtIsNil()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	todoItem.Edges.Children = children
+	todoDTO := dto.ConvertTodoToDTO(todoItem)
+	return &todoDTO, nil
+}
+
+func orderTags(q *ent.TagQuery) {
+	q.Order(tag.ByName())
+}
+
+// liveChildren loads the subtasks outside the trash, which ConvertTodoToDTO rolls up into the progress.
+func liveChildren(q *ent.TodoQuery) {
+	q.Where(todo.DeletedAtIsNil()).Select(todo.FieldID, todo.FieldStatus, todo.FieldCompletedAt, todo.FieldParentID)
+}
+
+// ensureTags returns the IDs of the user's tags with the given names, creating the missing ones.
+func ensureTags(ctx context.Context, client *ent.Client, userID int, names []string) ([]int, error) {
+	wanted := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = normalizeTagName(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		wanted = append(wanted, name)
+	}
+	if len(wanted) == 0 {
+		return nil, nil
+	}
+
+	existing, err := client.Tag.Query().
+		Where(tag.UserID(userID), tag.NameIn(wanted...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, 0, len(wanted))
+	found := make(map[string]bool, len(existing))
+	for _, t := range existing {
+		ids = append(ids, t.ID)
+		found[t.Name] = true
+	}
+
+	var creates []*ent.TagCreate
+	for _, name := range wanted {
+		if !found[name] {
+			creates = append(creates, client.Tag.Create().SetName(name).SetUserID(userID))
+		}
 	}
-	return todoDTOs, nil
+	if len(creates) > 0 {
+		created, err := client.Tag.CreateBulk(creates...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range created {
+			ids = append(ids, t.ID)
+		}
+	}
+	return ids, nil
+}
+
+// lastPosition returns the largest position among the user's todos, or "" if there are none.
+// Trashed todos are included so that a restored todo does not share its position with a new one.
+// It must be called with the client of the transaction that uses the position, see lockUserTodos.
+func lastPosition(ctx context.Context, client *ent.Client, userID int) (string, error) {
+	if err := lockUserTodos(ctx, client, userID); err != nil {
+		return "", err
+	}
+	last, err := client.Todo.Query().
+		Where(todo.UserID(userID)).
+		Order(todo.ByPosition(sql.OrderDesc())).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return last.Position, nil
+}
+
+// lockUserTodos locks the user's row until the end of the transaction of client, so that
+// transactions placing the user's todos or changing their tree wait for each other instead of
+// computing the same position or together nesting subtasks deeper than MaxTodoDepth.
+// It must be the first query of the transaction, so that later reads see the changes it waited for.
+func lockUserTodos(ctx context.Context, client *ent.Client, userID int) error {
+	_, err := client.User.Query().Where(user.ID(userID)).ForUpdate().OnlyID(ctx)
+	return err
+}
+
+// checkProject verifies that todos can be added to the user's project.
+func checkProject(ctx context.Context, client *ent.Client, userID, projectID int) error {
+	projectItem, err := client.Project.Query().
+		Where(project.ID(projectID), project.UserID(userID), project.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return projectError(err, "Project not found")
+	}
+	if projectItem.Archived {
+		return apperror.New(apperror.CodeProjectArchived, "Cannot add todos to an archived project")
+	}
+	return nil
+}
+
+// todoPriority converts the priority name of a form into its stored value.
+func todoPriority(name string) (int, error) {
+	priority, ok := dto.PriorityValue(name)
+	if !ok {
+		return 0, apperror.Newf(apperror.CodeValidationFailed, "Unknown priority %q", name)
+	}
+	return priority, nil
+}
+
+// checkVersion fails with PRECONDITION_FAILED when version is set and differs from the version of the todo.
+func checkVersion(current *ent.Todo, version *int) error {
+	if version != nil && *version != current.Version {
+		return errVersionMismatch
+	}
+	return nil
+}
+
+// versionError converts the not-found error of an update guarded by the version read in the
+// same transaction: the todo was changed concurrently in between.
+func versionError(err error) error {
+	if ent.IsNotFound(err) {
+		return apperror.Wrap(err, apperror.CodePreconditionFailed, errVersionMismatch.Message)
+	}
+	return err
+}
+
+// todoError converts ent's not-found error into TODO_NOT_FOUND with the given message.
+func todoError(err error, notFoundMessage string) error {
+	if ent.IsNotFound(err) {
+		return apperror.Wrap(err, apperror.CodeTodoNotFound, notFoundMessage)
+	}
+	return err
 }
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"time"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+)
+
+// MaxTodoDepth is the maximum number of levels of a todo tree, counting the top-level todo.
+const MaxTodoDepth = 3
+
+var (
+	errTodoDepthExceeded = apperror.Newf(apperror.CodeSubtaskDepthExceeded, "Subtasks cannot be nested more than %d levels deep", MaxTodoDepth)
+	errTodoCycle         = apperror.New(apperror.CodeSubtaskCycle, "A todo cannot be moved under itself or one of its subtasks")
+)
+
+func (s *todoService) CreateSubtask(ctx context.Context, userID, parentID int, form dto.TodoForm) (*dto.TodoDTO, error) {
+	return s.createTodo(ctx, userID, form, &parentID)
+}
+
+func (s *todoService) ListSubtasks(ctx context.Context, userID, parentID int) ([]dto.TodoDTO, error) {
+	parent, err := s.client.Todo.Query().
+		Where(todo.ID(parentID), todo.UserID(userID), todo.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, todoError(err, "Todo not found")
+	}
+	children, err := s.client.Todo.QueryChildren(parent).
+		Where(todo.DeletedAtIsNil()).
+		Order(todo.ByPosition(), todo.ByID()).
+		WithTags(orderTags).
+		WithChildren(liveChildren).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	todoDTOs := make([]dto.TodoDTO, len(children))
+	for i, child := range children {
+		todoDTOs[i] = dto.ConvertTodoToDTO(child)
+	}
+	return todoDTOs, nil
+}
+
+func (s *todoService) SetTodoParent(ctx context.Context, userID, id int, parentID *int) (*dto.TodoDTO, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := lockUserTodos(ctx, tx.Client(), userID); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	todoItem, err := tx.Todo.Query().
+		Where(todo.ID(id), todo.UserID(userID), todo.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, todoError(err, "Todo not found"))
+	}
+
+	update := tx.Todo.UpdateOne(todoItem)
+	if parentID == nil {
+		update.ClearParentID()
+	} else {
+		parent, err := tx.Todo.Query().
+			Where(todo.ID(*parentID), todo.UserID(userID), todo.DeletedAtIsNil()).
+			Only(ctx)
+		if err != nil {
+			return nil, rollback(tx, todoError(err, "Parent todo not found"))
+		}
+		if err := checkParent(ctx, tx.Client(), todoItem, parent); err != nil {
+			return nil, rollback(tx, err)
+		}
+		update.SetParentID(parent.ID)
+	}
+
+	todoItem, err = update.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := s.completeParents(ctx, tx.Client(), todoItem); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return s.todoDTO(ctx, todoItem)
+}
+
+// subtaskParent returns the user's todo that a new subtask is created under, rejecting it when
+// the subtask would be nested deeper than MaxTodoDepth.
+func subtaskParent(ctx context.Context, client *ent.Client, userID, parentID int) (*ent.Todo, error) {
+	parent, err := client.Todo.Query().
+		Where(todo.ID(parentID), todo.UserID(userID), todo.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, todoError(err, "Parent todo not found")
+	}
+	ancestors, err := ancestorIDs(ctx, client, parent)
+	if err != nil {
+		return nil, err
+	}
+	if len(ancestors)+1 > MaxTodoDepth {
+		return nil, errTodoDepthExceeded
+	}
+	return parent, nil
+}
+
+// checkParent rejects moving todoItem under parent when it would create a cycle
+// or nest the subtree of todoItem deeper than MaxTodoDepth.
+func checkParent(ctx context.Context, client *ent.Client, todoItem, parent *ent.Todo) error {
+	ancestors, err := ancestorIDs(ctx, client, parent)
+	if err != nil {
+		return err
+	}
+	for _, ancestor := range ancestors {
+		if ancestor == todoItem.ID {
+			return errTodoCycle
+		}
+	}
+	height, err := subtreeHeight(ctx, client, todoItem.ID)
+	if err != nil {
+		return err
+	}
+	if len(ancestors)+height > MaxTodoDepth {
+		return errTodoDepthExceeded
+	}
+	return nil
+}
+
+// ancestorIDs returns the IDs of the todo and of its ancestors, starting with the todo itself.
+func ancestorIDs(ctx context.Context, client *ent.Client, todoItem *ent.Todo) ([]int, error) {
+	ids := []int{todoItem.ID}
+	// 데이터가 잘못되어 순환이 있더라도 끝나도록 최대 깊이만큼만 따라갑니다.
+	for todoItem.ParentID != nil && len(ids) <= MaxTodoDepth {
+		parent, err := client.Todo.Get(ctx, *todoItem.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, parent.ID)
+		todoItem = parent
+	}
+	return ids, nil
+}
+
+// subtreeHeight returns the number of levels of the subtree rooted at the todo, counting the todo itself.
+// Trashed subtasks are counted because they can be restored.
+func subtreeHeight(ctx context.Context, client *ent.Client, id int) (int, error) {
+	height, level := 1, []int{id}
+	for height <= MaxTodoDepth {
+		children, err := client.Todo.Query().Where(todo.ParentIDIn(level...)).IDs(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if len(children) == 0 {
+			break
+		}
+		height++
+		level = children
+	}
+	return height, nil
+}
+
+// descendantIDs returns the IDs of all subtasks below the todo, trashed or not.
+func descendantIDs(ctx context.Context, client *ent.Client, id int) ([]int, error) {
+	var ids []int
+	level := []int{id}
+	for depth := 1; depth < MaxTodoDepth && len(level) > 0; depth++ {
+		children, err := client.Todo.Query().Where(todo.ParentIDIn(level...)).IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, children...)
+		level = children
+	}
+	return ids, nil
+}
+
+// completeParents moves the ancestors that have auto_complete set to the complete state of the
+// workflow once the done todo was their last open subtask. Ancestors whose state may not move
+// to the complete state are left as they are.
+func (s *todoService) completeParents(ctx context.Context, client *ent.Client, todoItem *ent.Todo) error {
+	complete := s.workflow.CompleteState()
+	for todoItem.CompletedAt != nil && todoItem.ParentID != nil {
+		parent, err := client.Todo.Query().
+			Where(todo.ID(*todoItem.ParentID), todo.DeletedAtIsNil()).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if !parent.AutoComplete || parent.CompletedAt != nil || !s.workflow.CanTransition(parent.Status, complete) {
+			return nil
+		}
+		open, err := client.Todo.Query().
+			Where(todo.ParentID(parent.ID), todo.DeletedAtIsNil(), todo.CompletedAtIsNil()).
+			Exist(ctx)
+		if err != nil || open {
+			return err
+		}
+		update := client.Todo.UpdateOne(parent)
+		transition, err := s.workflow.Apply(update.Mutation(), parent, complete, time.Now())
+		if err != nil {
+			return err
+		}
+		if todoItem, err = update.Save(ctx); err != nil {
+			return err
+		}
+		if err := recordTransition(ctx, client, todoItem.ID, transition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/todotransition"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/workflow"
+)
+
+func (s *todoService) ListTodoTransitions(ctx context.Context, userID, id int) ([]dto.TodoTransitionDTO, error) {
+	todoItem, err := s.client.Todo.Query().
+		Where(todo.ID(id), todo.UserID(userID), todo.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, todoError(err, "Todo not found")
+	}
+	transitions, err := s.client.Todo.QueryTransitions(todoItem).
+		Order(todotransition.ByCreatedAt(), todotransition.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transitionDTOs := make([]dto.TodoTransitionDTO, len(transitions))
+	for i, t := range transitions {
+		transitionDTOs[i] = dto.ConvertTodoTransitionToDTO(t)
+	}
+	return transitionDTOs, nil
+}
+
+// recordTransition stores the status change of a saved todo.
+// Nothing is stored when the status stayed the same.
+func recordTransition(ctx context.Context, client *ent.Client, todoID int, t workflow.Transition) error {
+	if t.From == t.To {
+		return nil
+	}
+	create := client.TodoTransition.Create().
+		SetTodoID(todoID).
+		SetToStatus(t.To).
+		SetCreatedAt(t.At)
+	if t.From != "" {
+		create.SetFromStatus(t.From)
+	}
+	return create.Exec(ctx)
+}
+
+func (s *todoService) GetWorkflow() dto.WorkflowDTO {
+	states := s.workflow.States()
+	workflowDTO := dto.WorkflowDTO{
+		States:   make([]dto.WorkflowStateDTO, len(states)),
+		Complete: s.workflow.CompleteState(),
+	}
+	for i, state := range states {
+		workflowDTO.States[i] = dto.WorkflowStateDTO{
+			Name:    state.Name,
+			Started: state.Started,
+			Done:    state.Done,
+			Next:    s.workflow.Targets(state.Name),
+		}
+	}
+	return workflowDTO
+}
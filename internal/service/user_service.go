@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/session"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrWrongPassword is returned when the current password given to change the password does not match.
+var ErrWrongPassword = apperror.New(apperror.CodeInvalidCredentials, "Current password is incorrect")
+
+// UserService defines the interface for User operations and implements it.
+type UserService interface {
+	CreateUser(ctx context.Context, form dto.SignupForm) (*dto.UserDTO, error)
+	GetUserByID(ctx context.Context, id int) (*dto.UserDTO, error)
+	// UpdateUser changes the profile of a user. Changing the password requires the current password
+	// and revokes every session of the user.
+	UpdateUser(ctx context.Context, id int, form dto.UpdateUserForm) (*dto.UserDTO, error)
+	DeleteUser(ctx context.Context, id int) error
+}
+
+// userService is the concrete implementation of UserService.
+type userService struct {
+	client *ent.Client
+}
+
+// NewUserService creates a new instance of userService.
+func NewUserService(client *ent.Client) UserService {
+	return &userService{client: client}
+}
+
+// Implement the methods defined in the UserService interface.
+
+func (s *userService) CreateUser(ctx context.Context, form dto.SignupForm) (*dto.UserDTO, error) {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(form.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	userItem, err := s.client.User.Create().
+		SetEmail(normalizeEmail(form.Email)).
+		SetDisplayName(form.DisplayName).
+		SetPasswordHash(string(passwordHash)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, apperror.Wrap(err, apperror.CodeEmailTaken, "Email already registered")
+		}
+		return nil, err
+	}
+	userDTO := dto.ConvertUserToDTO(userItem)
+	return &userDTO, nil
+}
+
+func (s *userService) GetUserByID(ctx context.Context, id int) (*dto.UserDTO, error) {
+	userItem, err := s.client.User.Get(ctx, id)
+	if err != nil {
+		return nil, userError(err)
+	}
+	userDTO := dto.ConvertUserToDTO(userItem)
+	return &userDTO, nil
+}
+
+func (s *userService) UpdateUser(ctx context.Context, id int, form dto.UpdateUserForm) (*dto.UserDTO, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userItem, err := tx.User.Get(ctx, id)
+	if err != nil {
+		return nil, rollback(tx, userError(err))
+	}
+	update := userItem.Update().
+		SetDisplayName(form.DisplayName)
+	if form.Password != "" {
+		if err := bcrypt.CompareHashAndPassword([]byte(userItem.PasswordHash), []byte(form.CurrentPassword)); err != nil {
+			return nil, rollback(tx, ErrWrongPassword)
+		}
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(form.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		update.SetPasswordHash(string(passwordHash))
+
+		// 비밀번호가 바뀌면 기존 리프레시 토큰으로 더 이상 새 토큰을 받을 수 없게 합니다.
+		err = tx.Session.Update().
+			Where(session.UserID(id), session.RevokedAtIsNil()).
+			SetRevokedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	if userItem, err = update.Save(ctx); err != nil {
+		return nil, rollback(tx, userError(err))
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	userDTO := dto.ConvertUserToDTO(userItem)
+	return &userDTO, nil
+}
+
+func (s *userService) DeleteUser(ctx context.Context, id int) error {
+	return userError(s.client.User.DeleteOneID(id).Exec(ctx))
+}
+
+// normalizeEmail lower-cases the address so that lookups are case-insensitive.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// userError converts ent's not-found error into USER_NOT_FOUND.
+func userError(err error) error {
+	if ent.IsNotFound(err) {
+		return apperror.Wrap(err, apperror.CodeUserNotFound, "User not found")
+	}
+	return err
+}
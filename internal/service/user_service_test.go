@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"todo-api-golang/ent/enttest"
+	"todo-api-golang/ent/session"
+	"todo-api-golang/internal/dto"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUpdateUserPassword(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	hash, _ := bcrypt.GenerateFromPassword([]byte("old password"), bcrypt.MinCost)
+	user := client.User.Create().SetEmail("me@example.com").SetDisplayName("me").SetPasswordHash(string(hash)).SaveX(ctx)
+	for i := 0; i < 2; i++ {
+		client.Session.Create().SetID(uuid.New()).SetUserID(user.ID).SetExpiresAt(time.Now().Add(time.Hour)).ExecX(ctx)
+	}
+	service := NewUserService(client)
+	live := func() int {
+		return client.Session.Query().Where(session.RevokedAtIsNil()).CountX(ctx)
+	}
+
+	_, err := service.UpdateUser(ctx, user.ID, dto.UpdateUserForm{DisplayName: "me", Password: "new password", CurrentPassword: "wrong"})
+	if !errors.Is(err, ErrWrongPassword) {
+		t.Fatalf("UpdateUser() with a wrong current password = %v, want ErrWrongPassword", err)
+	}
+	if live() != 2 {
+		t.Errorf("%d live sessions after a refused change, want 2", live())
+	}
+
+	if _, err := service.UpdateUser(ctx, user.ID, dto.UpdateUserForm{DisplayName: "me", Password: "new password", CurrentPassword: "old password"}); err != nil {
+		t.Fatal(err)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(client.User.GetX(ctx, user.ID).PasswordHash), []byte("new password")) != nil {
+		t.Error("the password was not changed")
+	}
+	if live() != 0 {
+		t.Errorf("%d live sessions after the password was changed, want 0", live())
+	}
+}
@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/webhook"
+	"todo-api-golang/ent/webhookdelivery"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	webhooks "todo-api-golang/internal/webhook"
+	"todo-api-golang/util"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// DefaultWebhookMaxAttempts is the number of attempts used when none is configured.
+	DefaultWebhookMaxAttempts = 8
+	// MaxDeliveryLog is the number of deliveries returned by the delivery log.
+	MaxDeliveryLog = 100
+	// deliveryLease is how long a claimed delivery is hidden from other workers while it is sent.
+	deliveryLease = 5 * time.Minute
+)
+
+// WebhookService defines the interface for Webhook operations and implements it.
+// The CRUD methods are scoped to the webhooks owned by the given user;
+// ClaimDueDeliveries and RecordDeliveryAttempt are used by the webhook worker.
+type WebhookService interface {
+	// CreateWebhook registers a webhook and returns it with its secret.
+	CreateWebhook(ctx context.Context, userID int, form dto.WebhookForm) (*dto.WebhookDTO, error)
+	ListWebhooks(ctx context.Context, userID int) ([]dto.WebhookDTO, error)
+	GetWebhook(ctx context.Context, userID, id int) (*dto.WebhookDTO, error)
+	// UpdateWebhook replaces the settings of a webhook. The secret is returned only when it was changed.
+	UpdateWebhook(ctx context.Context, userID, id int, form dto.WebhookForm) (*dto.WebhookDTO, error)
+	// DeleteWebhook deletes a webhook together with its delivery log.
+	DeleteWebhook(ctx context.Context, userID, id int) error
+	// ListDeliveries returns the latest deliveries of a webhook, newest first.
+	ListDeliveries(ctx context.Context, userID, webhookID int) ([]dto.WebhookDeliveryDTO, error)
+	// RedeliverDelivery queues the payload of a delivery to be sent again as a new delivery.
+	RedeliverDelivery(ctx context.Context, userID, webhookID, deliveryID int) (*dto.WebhookDeliveryDTO, error)
+	// ClaimDueDeliveries returns up to limit pending deliveries of active webhooks whose next attempt
+	// is due and postpones them so that they are not claimed twice while being sent. Due deliveries
+	// of inactive webhooks are marked failed instead.
+	ClaimDueDeliveries(ctx context.Context, now time.Time, limit int) ([]dto.WebhookDispatch, error)
+	// RecordDeliveryAttempt stores the outcome of sending a delivery and schedules the next attempt
+	// with exponential backoff, or marks the delivery failed after the last attempt.
+	RecordDeliveryAttempt(ctx context.Context, deliveryID int, attempt dto.WebhookAttempt) error
+}
+
+// webhookService is the concrete implementation of WebhookService.
+type webhookService struct {
+	client      *ent.Client
+	maxAttempts int
+}
+
+// NewWebhookService creates a new instance of webhookService.
+// config.WebhookMaxAttempts limits how often a delivery is attempted.
+func NewWebhookService(client *ent.Client, config util.Config) WebhookService {
+	maxAttempts := config.WebhookMaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultWebhookMaxAttempts
+	}
+	return &webhookService{client: client, maxAttempts: maxAttempts}
+}
+
+// Implement the methods defined in the WebhookService interface.
+
+func (s *webhookService) CreateWebhook(ctx context.Context, userID int, form dto.WebhookForm) (*dto.WebhookDTO, error) {
+	secret := form.Secret
+	if secret == "" {
+		var err error
+		if secret, err = newWebhookSecret(); err != nil {
+			return nil, err
+		}
+	}
+	webhookItem, err := s.client.Webhook.Create().
+		SetURL(form.URL).
+		SetSecret(secret).
+		SetEvents(form.Events).
+		SetActive(form.Active == nil || *form.Active).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	webhookDTO := dto.ConvertWebhookToDTO(webhookItem)
+	webhookDTO.Secret = secret
+	return &webhookDTO, nil
+}
+
+func (s *webhookService) ListWebhooks(ctx context.Context, userID int) ([]dto.WebhookDTO, error) {
+	webhookItems, err := s.client.Webhook.Query().
+		Where(webhook.UserID(userID)).
+		Order(webhook.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	webhookDTOs := make([]dto.WebhookDTO, len(webhookItems))
+	for i, webhookItem := range webhookItems {
+		webhookDTOs[i] = dto.ConvertWebhookToDTO(webhookItem)
+	}
+	return webhookDTOs, nil
+}
+
+func (s *webhookService) GetWebhook(ctx context.Context, userID, id int) (*dto.WebhookDTO, error) {
+	webhookItem, err := s.webhook(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	webhookDTO := dto.ConvertWebhookToDTO(webhookItem)
+	return &webhookDTO, nil
+}
+
+func (s *webhookService) UpdateWebhook(ctx context.Context, userID, id int, form dto.WebhookForm) (*dto.WebhookDTO, error) {
+	webhookItem, err := s.webhook(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	update := webhookItem.Update().
+		SetURL(form.URL).
+		SetEvents(form.Events).
+		SetActive(form.Active == nil || *form.Active)
+	if form.Secret != "" {
+		update.SetSecret(form.Secret)
+	}
+	if webhookItem, err = update.Save(ctx); err != nil {
+		return nil, err
+	}
+	webhookDTO := dto.ConvertWebhookToDTO(webhookItem)
+	webhookDTO.Secret = form.Secret
+	return &webhookDTO, nil
+}
+
+func (s *webhookService) DeleteWebhook(ctx context.Context, userID, id int) error {
+	deleted, err := s.client.Webhook.Delete().
+		Where(webhook.ID(id), webhook.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return apperror.New(apperror.CodeWebhookNotFound, "Webhook not found")
+	}
+	return nil
+}
+
+func (s *webhookService) ListDeliveries(ctx context.Context, userID, webhookID int) ([]dto.WebhookDeliveryDTO, error) {
+	webhookItem, err := s.webhook(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	deliveries, err := webhookItem.QueryDeliveries().
+		Order(webhookdelivery.ByID(sql.OrderDesc())).
+		Limit(MaxDeliveryLog).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deliveryDTOs := make([]dto.WebhookDeliveryDTO, len(deliveries))
+	for i, delivery := range deliveries {
+		deliveryDTOs[i] = dto.ConvertWebhookDeliveryToDTO(delivery)
+	}
+	return deliveryDTOs, nil
+}
+
+func (s *webhookService) RedeliverDelivery(ctx context.Context, userID, webhookID, deliveryID int) (*dto.WebhookDeliveryDTO, error) {
+	webhookItem, err := s.webhook(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	original, err := webhookItem.QueryDeliveries().
+		Where(webhookdelivery.ID(deliveryID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperror.Wrap(err, apperror.CodeDeliveryNotFound, "Delivery not found")
+		}
+		return nil, err
+	}
+	// 원래 전송 기록은 그대로 두고 같은 내용으로 새 전송을 만듭니다.
+	delivery, err := s.client.WebhookDelivery.Create().
+		SetWebhookID(webhookItem.ID).
+		SetEventID(original.EventID).
+		SetEventType(original.EventType).
+		SetPayload(original.Payload).
+		SetNextAttemptAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deliveryDTO := dto.ConvertWebhookDeliveryToDTO(delivery)
+	return &deliveryDTO, nil
+}
+
+func (s *webhookService) ClaimDueDeliveries(ctx context.Context, now time.Time, limit int) ([]dto.WebhookDispatch, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 비활성화된 웹훅의 전송은 보내지 않고 실패로 처리합니다.
+	err = tx.WebhookDelivery.Update().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+			webhookdelivery.HasWebhookWith(webhook.Active(false)),
+		).
+		SetStatus(webhookdelivery.StatusFailed).
+		SetLastError("webhook is inactive").
+		ClearNextAttemptAt().
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	due, err := tx.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+			webhookdelivery.HasWebhookWith(webhook.Active(true)),
+			skipLocked,
+		).
+		WithWebhook().
+		Order(webhookdelivery.ByNextAttemptAt(), webhookdelivery.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if len(due) == 0 {
+		return nil, tx.Commit()
+	}
+
+	ids := make([]int, len(due))
+	for i, d := range due {
+		ids[i] = d.ID
+	}
+	err = tx.WebhookDelivery.Update().
+		Where(webhookdelivery.IDIn(ids...), webhookdelivery.NextAttemptAtLTE(now)).
+		SetNextAttemptAt(now.Add(deliveryLease)).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	dispatches := make([]dto.WebhookDispatch, len(due))
+	for i, d := range due {
+		dispatches[i] = dto.WebhookDispatch{
+			DeliveryID: d.ID,
+			URL:        d.Edges.Webhook.URL,
+			Secret:     d.Edges.Webhook.Secret,
+			EventType:  d.EventType,
+			Payload:    d.Payload,
+		}
+	}
+	return dispatches, nil
+}
+
+func (s *webhookService) RecordDeliveryAttempt(ctx context.Context, deliveryID int, attempt dto.WebhookAttempt) error {
+	delivery, err := s.client.WebhookDelivery.Get(ctx, deliveryID)
+	if err != nil {
+		return err
+	}
+	attempts := delivery.Attempts + 1
+	update := delivery.Update().
+		SetAttempts(attempts).
+		SetNillableResponseStatus(attempt.ResponseStatus).
+		SetLastError(attempt.Error)
+	if attempt.ResponseStatus == nil {
+		update.ClearResponseStatus()
+	}
+
+	switch {
+	case attempt.Error == "":
+		update.SetStatus(webhookdelivery.StatusSucceeded).
+			SetDeliveredAt(attempt.At).
+			ClearNextAttemptAt()
+	case attempts >= s.maxAttempts:
+		update.SetStatus(webhookdelivery.StatusFailed).
+			ClearNextAttemptAt()
+	default:
+		update.SetNextAttemptAt(attempt.At.Add(webhooks.Backoff(attempts)))
+	}
+	return update.Exec(ctx)
+}
+
+// webhook loads a webhook of the user.
+func (s *webhookService) webhook(ctx context.Context, userID, id int) (*ent.Webhook, error) {
+	webhookItem, err := s.client.Webhook.Query().
+		Where(webhook.ID(id), webhook.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperror.Wrap(err, apperror.CodeWebhookNotFound, "Webhook not found")
+		}
+		return nil, err
+	}
+	return webhookItem, nil
+}
+
+// newWebhookSecret generates a random secret for signing deliveries.
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
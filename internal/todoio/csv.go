@@ -0,0 +1,155 @@
+package todoio
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+)
+
+// csvColumns are the columns of a CSV export. Tags are joined with commas.
+var csvColumns = []string{
+	"id", "title", "description", "status", "priority", "project_id", "tags", "parent_id",
+	"auto_complete", "due_at", "remind_at", "started_at", "completed_at", "created_at", "updated_at",
+}
+
+type csvEncoder struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func newCSVEncoder(w io.Writer, _ Options) Encoder {
+	return &csvEncoder{w: csv.NewWriter(w)}
+}
+
+func (e *csvEncoder) header() error {
+	if e.wroteHeader {
+		return nil
+	}
+	e.wroteHeader = true
+	return e.w.Write(csvColumns)
+}
+
+func (e *csvEncoder) Encode(todo dto.TodoDTO) error {
+	if err := e.header(); err != nil {
+		return err
+	}
+	return e.w.Write([]string{
+		strconv.Itoa(todo.ID),
+		todo.Title,
+		todo.Description,
+		todo.Status,
+		todo.Priority,
+		optionalInt(todo.ProjectID),
+		strings.Join(todo.Tags, ","),
+		optionalInt(todo.ParentID),
+		strconv.FormatBool(todo.AutoComplete),
+		formatTime(todo.DueAt),
+		formatTime(todo.RemindAt),
+		formatTime(todo.StartedAt),
+		formatTime(todo.CompletedAt),
+		formatTime(&todo.CreatedAt),
+		formatTime(&todo.UpdatedAt),
+	})
+}
+
+func (e *csvEncoder) Close() error {
+	if err := e.header(); err != nil {
+		return err
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// decodeCSV reads a CSV file whose first row names the columns. Columns are matched by name
+// regardless of case and order; columns that are not fields of a todo form are ignored.
+func decodeCSV(data []byte, _ Options) ([]dto.ImportRow, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, csvError(err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["title"]; !ok {
+		return nil, apperror.New(apperror.CodeBadRequest, "The CSV header must contain a title column")
+	}
+
+	var rows []dto.ImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, csvError(err)
+		}
+		line, _ := reader.FieldPos(0)
+		value := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		form, err := csvForm(value)
+		rows = append(rows, dto.ImportRow{Line: line, Form: form, Err: err})
+	}
+}
+
+// csvForm converts the values of a CSV row into a todo form.
+func csvForm(value func(column string) string) (dto.TodoForm, error) {
+	form := dto.TodoForm{
+		Title:       value("title"),
+		Description: value("description"),
+		Status:      value("status"),
+		Priority:    value("priority"),
+		Tags:        splitTags(value("tags")),
+	}
+	var err error
+	if raw := value("project_id"); raw != "" {
+		projectID, err := strconv.Atoi(raw)
+		if err != nil {
+			return form, invalidValue("project_id", "int", raw)
+		}
+		form.ProjectID = &projectID
+	}
+	if raw := value("auto_complete"); raw != "" {
+		if form.AutoComplete, err = strconv.ParseBool(raw); err != nil {
+			return form, invalidValue("auto_complete", "bool", raw)
+		}
+	}
+	if form.DueAt, err = parseTime("due_at", value("due_at")); err != nil {
+		return form, err
+	}
+	if form.RemindAt, err = parseTime("remind_at", value("remind_at")); err != nil {
+		return form, err
+	}
+	return form, nil
+}
+
+func csvError(err error) error {
+	if errors.Is(err, io.EOF) {
+		return apperror.New(apperror.CodeEmptyBody, "Request body must not be empty")
+	}
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return apperror.Wrap(err, apperror.CodeBadRequest, "Malformed CSV on line "+strconv.Itoa(parseErr.Line))
+	}
+	return apperror.Wrap(err, apperror.CodeBadRequest, "Failed to read CSV")
+}
+
+// optionalInt writes an optional ID; nil is written as "".
+func optionalInt(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
@@ -0,0 +1,308 @@
+package todoio
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/ical"
+)
+
+// An iCalendar export is a VCALENDAR with a VTODO per todo. The status of the workflow is
+// mapped to the STATUS of the VTODO and kept as is in X-TODO-STATUS together with the STATUS
+// it was mapped to; imports prefer X-TODO-STATUS unless a calendar app changed the STATUS since.
+// The reminder becomes a VALARM. Every VTODO carries the UID of its todo, so importing an
+// export again updates the todos instead of creating copies:
+//
+//	BEGIN:VTODO
+//	UID:1f0c7a52-3b8e-4d7e-9d1c-6a0c3e8f2b11
+//	SUMMARY:Buy milk
+//	STATUS:NEEDS-ACTION
+//	X-TODO-STATUS;X-STATUS=NEEDS-ACTION:PENDING
+//	PRIORITY:3
+//	DUE:20260102T090000Z
+//	END:VTODO
+
+const (
+	icsProductID    = "-//todo-api-golang//Todos//EN"
+	icsCalendarName = "Todos"
+)
+
+// Values of the STATUS property of a VTODO.
+const (
+	icsNeedsAction = "NEEDS-ACTION"
+	icsInProcess   = "IN-PROCESS"
+	icsCompleted   = "COMPLETED"
+	icsCancelled   = "CANCELLED"
+)
+
+// icsPriorities maps the priorities of a todo to the PRIORITY of a VTODO, where 1 is the highest
+// and 9 the lowest priority.
+var icsPriorities = map[string]int{
+	dto.PriorityUrgent: 1,
+	dto.PriorityHigh:   3,
+	dto.PriorityMedium: 5,
+	dto.PriorityLow:    9,
+}
+
+type icsEncoder struct {
+	w           *ical.Writer
+	now         time.Time
+	wroteHeader bool
+}
+
+func newICSEncoder(w io.Writer, _ Options) Encoder {
+	return &icsEncoder{w: ical.NewWriter(w), now: time.Now()}
+}
+
+func (e *icsEncoder) header() error {
+	if e.wroteHeader {
+		return nil
+	}
+	e.wroteHeader = true
+	e.w.Begin("VCALENDAR")
+	e.w.Text("VERSION", "2.0")
+	e.w.Text("PRODID", icsProductID)
+	e.w.Text("CALSCALE", "GREGORIAN")
+	return e.w.Text("X-WR-CALNAME", icsCalendarName)
+}
+
+func (e *icsEncoder) Encode(todo dto.TodoDTO) error {
+	if err := e.header(); err != nil {
+		return err
+	}
+	// Writer는 첫 에러를 기억하므로 마지막 호출의 에러만 확인합니다.
+	w := e.w
+	w.Begin("VTODO")
+	w.Text("UID", todo.UID)
+	w.Time("DTSTAMP", e.now)
+	w.Time("CREATED", todo.CreatedAt)
+	w.Time("LAST-MODIFIED", todo.UpdatedAt)
+	w.Text("SUMMARY", todo.Title)
+	if todo.Description != "" {
+		w.Text("DESCRIPTION", todo.Description)
+	}
+	status := icsStatus(todo)
+	w.Text("STATUS", status)
+	w.Write(ical.Property{Name: "X-TODO-STATUS", Params: map[string]string{"X-STATUS": status}, Value: ical.EscapeText(todo.Status)})
+	if priority, ok := icsPriorities[todo.Priority]; ok {
+		w.Text("PRIORITY", strconv.Itoa(priority))
+	}
+	if len(todo.Tags) > 0 {
+		w.TextList("CATEGORIES", todo.Tags)
+	}
+	if todo.ProjectID != nil {
+		w.Text("X-TODO-PROJECT-ID", strconv.Itoa(*todo.ProjectID))
+	}
+	if todo.AutoComplete {
+		w.Text("X-TODO-AUTO-COMPLETE", "TRUE")
+	}
+	if todo.DueAt != nil {
+		w.Time("DUE", *todo.DueAt)
+	}
+	if todo.StartedAt != nil {
+		w.Time("DTSTART", *todo.StartedAt)
+	}
+	if todo.CompletedAt != nil {
+		w.Time("COMPLETED", *todo.CompletedAt)
+	}
+	if todo.RemindAt != nil {
+		w.Begin("VALARM")
+		w.Text("ACTION", "DISPLAY")
+		w.Text("DESCRIPTION", todo.Title)
+		w.Write(ical.Property{Name: "TRIGGER", Params: map[string]string{"VALUE": "DATE-TIME"}, Value: ical.FormatTime(*todo.RemindAt)})
+		w.End("VALARM")
+	}
+	return w.End("VTODO")
+}
+
+func (e *icsEncoder) Close() error {
+	if err := e.header(); err != nil {
+		return err
+	}
+	return e.w.End("VCALENDAR")
+}
+
+// icsStatus maps the state of a todo to the STATUS of a VTODO. Done states other than
+// CANCELLED are COMPLETED.
+func icsStatus(todo dto.TodoDTO) string {
+	switch {
+	case todo.CompletedAt != nil && todo.Status == icsCancelled:
+		return icsCancelled
+	case todo.CompletedAt != nil:
+		return icsCompleted
+	case todo.StartedAt != nil:
+		return icsInProcess
+	default:
+		return icsNeedsAction
+	}
+}
+
+// decodeICS reads the VTODO components of an iCalendar file; other components, such as events,
+// are ignored. A VTODO without an up to date X-TODO-STATUS gets the default matching its STATUS.
+func decodeICS(data []byte, options Options) ([]dto.ImportRow, error) {
+	root, err := ical.Parse(data)
+	if err != nil {
+		var parseErr *ical.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, apperror.Wrap(err, apperror.CodeBadRequest, "Malformed iCalendar on line "+strconv.Itoa(parseErr.Line))
+		}
+		return nil, apperror.Wrap(err, apperror.CodeBadRequest, "Failed to read iCalendar")
+	}
+	var rows []dto.ImportRow
+	for _, vtodo := range root.Find("VTODO") {
+		row := dto.ImportRow{Line: vtodo.Line}
+		if uid := vtodo.Get("UID"); uid != nil {
+			row.UID = strings.TrimSpace(uid.Text())
+		}
+		row.Form, row.Err = icsForm(vtodo, options.Defaults)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// icsForm converts a VTODO into a todo form.
+func icsForm(vtodo *ical.Component, defaults Defaults) (dto.TodoForm, error) {
+	var form dto.TodoForm
+	if p := vtodo.Get("SUMMARY"); p != nil {
+		form.Title = strings.TrimSpace(p.Text())
+	}
+	if p := vtodo.Get("DESCRIPTION"); p != nil {
+		form.Description = p.Text()
+	}
+
+	status, err := icsImportStatus(vtodo, defaults)
+	if err != nil {
+		return form, err
+	}
+	form.Status = status
+
+	if p := vtodo.Get("PRIORITY"); p != nil {
+		priority, err := strconv.Atoi(strings.TrimSpace(p.Value))
+		if err != nil || priority < 0 || priority > 9 {
+			return form, invalidValue("priority", "PRIORITY", p.Value)
+		}
+		form.Priority = icsPriorityName(priority)
+	}
+	for _, p := range vtodo.All("CATEGORIES") {
+		for _, tag := range p.TextList() {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				form.Tags = append(form.Tags, tag)
+			}
+		}
+	}
+	if p := vtodo.Get("X-TODO-PROJECT-ID"); p != nil {
+		projectID, err := strconv.Atoi(strings.TrimSpace(p.Value))
+		if err != nil {
+			return form, invalidValue("project_id", "int", p.Value)
+		}
+		form.ProjectID = &projectID
+	}
+	if p := vtodo.Get("X-TODO-AUTO-COMPLETE"); p != nil {
+		if form.AutoComplete, err = strconv.ParseBool(strings.TrimSpace(p.Value)); err != nil {
+			return form, invalidValue("auto_complete", "bool", p.Value)
+		}
+	}
+
+	if p := vtodo.Get("DUE"); p != nil {
+		due, err := p.Time(time.Local)
+		if err != nil {
+			return form, invalidValue("due_at", "DATE-TIME", p.Value)
+		}
+		form.DueAt = &due
+	}
+	if form.RemindAt, err = icsReminder(vtodo, form.DueAt); err != nil {
+		return form, err
+	}
+	return form, nil
+}
+
+// icsImportStatus returns the status of an imported VTODO.
+func icsImportStatus(vtodo *ical.Component, defaults Defaults) (string, error) {
+	p := vtodo.Get("STATUS")
+	if x := vtodo.Get("X-TODO-STATUS"); x != nil && x.Value != "" {
+		if p == nil || strings.EqualFold(strings.TrimSpace(p.Value), x.Param("X-STATUS")) {
+			return x.Text(), nil
+		}
+	}
+	if p == nil {
+		// STATUS 없이 COMPLETED만 있는 클라이언트도 있습니다.
+		if vtodo.Get("COMPLETED") != nil {
+			return defaults.Done, nil
+		}
+		return defaults.Open, nil
+	}
+	switch strings.ToUpper(strings.TrimSpace(p.Value)) {
+	case icsNeedsAction:
+		return defaults.Open, nil
+	case icsInProcess:
+		return defaults.Started, nil
+	case icsCompleted:
+		return defaults.Done, nil
+	case icsCancelled:
+		return defaults.Cancelled, nil
+	default:
+		return "", invalidValue("status", "STATUS", p.Value)
+	}
+}
+
+// icsPriorityName maps the PRIORITY of a VTODO to the priority of a todo; 0 means undefined
+// and leaves the default priority.
+func icsPriorityName(priority int) string {
+	switch {
+	case priority == 0:
+		return ""
+	case priority == 1:
+		return dto.PriorityUrgent
+	case priority <= 4:
+		return dto.PriorityHigh
+	case priority == 5:
+		return dto.PriorityMedium
+	default:
+		return dto.PriorityLow
+	}
+}
+
+// icsReminder returns the time of the first VALARM of a VTODO. Relative triggers are only
+// supported relative to the due time, or to the start when the VTODO has one.
+func icsReminder(vtodo *ical.Component, due *time.Time) (*time.Time, error) {
+	for _, alarm := range vtodo.Components {
+		if alarm.Name != "VALARM" {
+			continue
+		}
+		trigger := alarm.Get("TRIGGER")
+		if trigger == nil {
+			continue
+		}
+		if trigger.Param("VALUE") == "DATE-TIME" {
+			remind, err := trigger.Time(time.Local)
+			if err != nil {
+				return nil, invalidValue("remind_at", "DATE-TIME", trigger.Value)
+			}
+			return &remind, nil
+		}
+		offset, err := trigger.Duration()
+		if err != nil {
+			return nil, invalidValue("remind_at", "DURATION", trigger.Value)
+		}
+		anchor := due
+		if trigger.Param("RELATED") != "END" {
+			if start := vtodo.Get("DTSTART"); start != nil {
+				t, err := start.Time(time.Local)
+				if err != nil {
+					return nil, invalidValue("remind_at", "DATE-TIME", start.Value)
+				}
+				anchor = &t
+			}
+		}
+		if anchor == nil {
+			continue
+		}
+		remind := anchor.Add(offset)
+		return &remind, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,178 @@
+package todoio
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"todo-api-golang/internal/dto"
+)
+
+// A Markdown export is a checklist with an item per todo. Checked items are the todos in a done
+// state. The fields that Markdown has no syntax for follow the title as key:value pairs in an
+// HTML comment, which renderers hide; the description is indented below the item:
+//
+//	- [ ] Buy milk <!-- status:PENDING priority:HIGH tags:groceries,home due:2026-01-02T09:00:00Z -->
+//	  Two litres, semi-skimmed.
+
+var (
+	checklistItem   = regexp.MustCompile(`^\s*[-*+]\s+\[([ xX])\]\s+(.*)$`)
+	metadataComment = regexp.MustCompile(`\s*<!--(.*?)-->\s*$`)
+)
+
+type markdownEncoder struct {
+	w           io.Writer
+	wroteHeader bool
+}
+
+func newMarkdownEncoder(w io.Writer, _ Options) Encoder {
+	return &markdownEncoder{w: w}
+}
+
+func (e *markdownEncoder) header() error {
+	if e.wroteHeader {
+		return nil
+	}
+	e.wroteHeader = true
+	_, err := io.WriteString(e.w, "# Todos\n\n")
+	return err
+}
+
+func (e *markdownEncoder) Encode(todo dto.TodoDTO) error {
+	if err := e.header(); err != nil {
+		return err
+	}
+	check := " "
+	if todo.CompletedAt != nil {
+		check = "x"
+	}
+	metadata := []string{"status:" + todo.Status, "priority:" + todo.Priority}
+	if todo.ProjectID != nil {
+		metadata = append(metadata, "project:"+strconv.Itoa(*todo.ProjectID))
+	}
+	if len(todo.Tags) > 0 {
+		tags := make([]string, len(todo.Tags))
+		for i, tag := range todo.Tags {
+			tags[i] = url.QueryEscape(tag)
+		}
+		metadata = append(metadata, "tags:"+strings.Join(tags, ","))
+	}
+	if todo.AutoComplete {
+		metadata = append(metadata, "auto_complete:true")
+	}
+	if todo.DueAt != nil {
+		metadata = append(metadata, "due:"+formatTime(todo.DueAt))
+	}
+	if todo.RemindAt != nil {
+		metadata = append(metadata, "remind:"+formatTime(todo.RemindAt))
+	}
+
+	var b strings.Builder
+	title := strings.Join(strings.Fields(todo.Title), " ")
+	fmt.Fprintf(&b, "- [%s] %s <!-- %s -->\n", check, title, strings.Join(metadata, " "))
+	if todo.Description != "" {
+		for _, line := range strings.Split(todo.Description, "\n") {
+			b.WriteString("  " + strings.TrimRight(line, "\r") + "\n")
+		}
+	}
+	_, err := io.WriteString(e.w, b.String())
+	return err
+}
+
+func (e *markdownEncoder) Close() error {
+	return e.header()
+}
+
+// decodeMarkdown reads the checklist items of a Markdown file; every other line is ignored.
+// Items without a status in their metadata get the Done default when checked and the Open default otherwise.
+func decodeMarkdown(data []byte, options Options) ([]dto.ImportRow, error) {
+	var (
+		rows        []dto.ImportRow
+		description []string
+	)
+	// 항목 아래에 들여쓴 줄은 설명으로 모아 두었다가 다음 항목에서 이전 항목에 붙입니다.
+	flush := func() {
+		if len(rows) > 0 && len(description) > 0 {
+			rows[len(rows)-1].Form.Description = strings.TrimRight(strings.Join(description, "\n"), "\n")
+		}
+		description = nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	inItem := false
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if match := checklistItem.FindStringSubmatch(text); match != nil {
+			flush()
+			form, err := markdownForm(match[1] != " ", match[2], options.Defaults)
+			rows = append(rows, dto.ImportRow{Line: line, Form: form, Err: err})
+			inItem = true
+			continue
+		}
+		if inItem && (strings.HasPrefix(text, "  ") || strings.HasPrefix(text, "\t")) {
+			description = append(description, strings.TrimPrefix(strings.TrimPrefix(text, "  "), "\t"))
+			continue
+		}
+		flush()
+		inItem = false
+	}
+	flush()
+	return rows, scanner.Err()
+}
+
+// markdownForm converts the text of a checklist item into a todo form.
+func markdownForm(checked bool, text string, defaults Defaults) (dto.TodoForm, error) {
+	form := dto.TodoForm{Status: defaults.Open}
+	if checked {
+		form.Status = defaults.Done
+	}
+
+	var metadata string
+	if loc := metadataComment.FindStringSubmatchIndex(text); loc != nil {
+		metadata = text[loc[2]:loc[3]]
+		text = text[:loc[0]]
+	}
+	form.Title = strings.TrimSpace(text)
+
+	var err error
+	for _, pair := range strings.Fields(metadata) {
+		key, value, _ := strings.Cut(pair, ":")
+		switch key {
+		case "status":
+			form.Status = value
+		case "priority":
+			form.Priority = value
+		case "project":
+			projectID, err := strconv.Atoi(value)
+			if err != nil {
+				return form, invalidValue("project_id", "int", value)
+			}
+			form.ProjectID = &projectID
+		case "tags":
+			for _, tag := range splitTags(value) {
+				if tag, err = url.QueryUnescape(tag); err != nil {
+					return form, invalidValue("tags", "string", value)
+				}
+				form.Tags = append(form.Tags, tag)
+			}
+		case "auto_complete":
+			if form.AutoComplete, err = strconv.ParseBool(value); err != nil {
+				return form, invalidValue("auto_complete", "bool", value)
+			}
+		case "due":
+			if form.DueAt, err = parseTime("due_at", value); err != nil {
+				return form, err
+			}
+		case "remind":
+			if form.RemindAt, err = parseTime("remind_at", value); err != nil {
+				return form, err
+			}
+		}
+	}
+	return form, nil
+}
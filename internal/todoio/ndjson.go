@@ -0,0 +1,69 @@
+package todoio
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+)
+
+type ndjsonEncoder struct {
+	encoder *json.Encoder
+}
+
+func newNDJSONEncoder(w io.Writer, _ Options) Encoder {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	return &ndjsonEncoder{encoder: encoder}
+}
+
+// Encode writes the todo as a single line of JSON, in the representation of the REST API.
+func (e *ndjsonEncoder) Encode(todo dto.TodoDTO) error {
+	return e.encoder.Encode(todo)
+}
+
+func (e *ndjsonEncoder) Close() error {
+	return nil
+}
+
+// decodeNDJSON reads a JSON object per line. Blank lines are skipped and the fields of the
+// REST representation that are not part of a todo form, such as id, are ignored.
+func decodeNDJSON(data []byte, _ Options) ([]dto.ImportRow, error) {
+	var rows []dto.ImportRow
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var form dto.TodoForm
+		err := jsonError(json.Unmarshal(line, &form))
+		rows = append(rows, dto.ImportRow{Line: i + 1, Form: form, Err: err})
+	}
+	return rows, nil
+}
+
+func jsonError(err error) error {
+	var (
+		syntaxError        *json.SyntaxError
+		unmarshalTypeError *json.UnmarshalTypeError
+	)
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &unmarshalTypeError):
+		return apperror.New(apperror.CodeInvalidType, "Row contains a value of the wrong type").
+			WithFields(apperror.FieldError{
+				Field: unmarshalTypeError.Field,
+				Rule:  "type",
+				Param: unmarshalTypeError.Type.String(),
+				Value: unmarshalTypeError.Value,
+			})
+	case errors.As(err, &syntaxError):
+		return apperror.Wrap(err, apperror.CodeMalformedJSON, "Malformed JSON")
+	default:
+		// time.Time 같은 필드의 파싱 실패는 별도의 에러 타입이 없습니다.
+		return apperror.Wrap(err, apperror.CodeInvalidType, "Row contains a value of the wrong type")
+	}
+}
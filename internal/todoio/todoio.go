@@ -0,0 +1,169 @@
+// Package todoio reads and writes todos in the file formats of the export and import endpoints:
+// CSV with a header row, newline-delimited JSON, a Markdown checklist, iCalendar and todo.txt.
+//
+// Every format round-trips: a file exported by an Encoder can be imported with Decode.
+// Only the fields of dto.TodoForm and the iCalendar UID are imported; IDs, subtask relations and
+// timestamps other than due_at and remind_at are written for reference and ignored when reading.
+// todo.txt has no room for descriptions, which are left out of its exports.
+package todoio
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"time"
+	"todo-api-golang/internal/apperror"
+	"todo-api-golang/internal/dto"
+)
+
+// format describes a file format.
+type format struct {
+	name        string
+	contentType string
+	mediaType   string
+	extension   string
+	newEncoder  func(w io.Writer, options Options) Encoder
+	decode      func(data []byte, options Options) ([]dto.ImportRow, error)
+}
+
+var formats = []format{
+	{dto.FormatCSV, "text/csv; charset=utf-8", "text/csv", "csv", newCSVEncoder, decodeCSV},
+	{dto.FormatNDJSON, "application/x-ndjson", "application/x-ndjson", "ndjson", newNDJSONEncoder, decodeNDJSON},
+	{dto.FormatMarkdown, "text/markdown; charset=utf-8", "text/markdown", "md", newMarkdownEncoder, decodeMarkdown},
+	{dto.FormatICS, "text/calendar; charset=utf-8", "text/calendar", "ics", newICSEncoder, decodeICS},
+	{dto.FormatTodoTxt, "text/plain; charset=utf-8", "text/plain", "txt", newTodoTxtEncoder, decodeTodoTxt},
+}
+
+// Options holds what the formats need to know about the user besides the todos.
+type Options struct {
+	// Defaults are the statuses of todos whose format does not carry one.
+	Defaults Defaults
+	// Projects maps the IDs of the user's projects to their names, for formats that refer to
+	// projects by name.
+	Projects map[int]string
+}
+
+// Defaults holds the statuses given to todos whose format does not carry one,
+// i.e. Markdown checklist items and todo.txt tasks without a status and VTODOs from other
+// calendar apps.
+type Defaults struct {
+	// Open is the status of unchecked items, open tasks and VTODOs that need action.
+	Open string
+	// Started is the status of VTODOs in process.
+	Started string
+	// Done is the status of checked items, completed tasks and completed VTODOs.
+	Done string
+	// Cancelled is the status of cancelled VTODOs.
+	Cancelled string
+}
+
+// Encoder writes todos in a file format.
+type Encoder interface {
+	// Encode writes a todo.
+	Encode(todo dto.TodoDTO) error
+	// Close writes what is left of the file, e.g. the header of an empty export.
+	Close() error
+}
+
+// NewEncoder returns an Encoder writing the named format to w.
+func NewEncoder(name string, w io.Writer, options Options) (Encoder, error) {
+	f, err := lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	return f.newEncoder(w, options), nil
+}
+
+// Decode reads the todos of a file in the named format. Rows that cannot be read carry the error
+// in ImportRow.Err; an error is only returned when the file as a whole cannot be read.
+func Decode(name string, data []byte, options Options) ([]dto.ImportRow, error) {
+	f, err := lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	// 스프레드시트 프로그램이 붙이는 UTF-8 BOM을 제거합니다.
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	return f.decode(data, options)
+}
+
+// ContentType returns the Content-Type of files in the named format.
+func ContentType(name string) string {
+	f, _ := lookup(name)
+	return f.contentType
+}
+
+// Filename returns the file name of an export in the named format.
+func Filename(name string) string {
+	f, _ := lookup(name)
+	return "todos." + f.extension
+}
+
+// FormatOf returns the format of files with the given media type.
+func FormatOf(mediaType string) (string, bool) {
+	for _, f := range formats {
+		if f.mediaType == mediaType {
+			return f.name, true
+		}
+	}
+	return "", false
+}
+
+// MediaTypes returns the media types of all formats.
+func MediaTypes() []string {
+	mediaTypes := make([]string, len(formats))
+	for i, f := range formats {
+		mediaTypes[i] = f.mediaType
+	}
+	return mediaTypes
+}
+
+func lookup(name string) (format, error) {
+	names := make([]string, len(formats))
+	for i, f := range formats {
+		if f.name == name {
+			return f, nil
+		}
+		names[i] = f.name
+	}
+	return format{}, apperror.Newf(apperror.CodeInvalidQuery, "format must be one of %s", strings.Join(names, ", "))
+}
+
+// invalidValue is the error of a row with a value that cannot be converted to its field.
+func invalidValue(field, kind, value string) error {
+	return apperror.New(apperror.CodeInvalidType, "Row contains a value of the wrong type").
+		WithFields(apperror.FieldError{Field: field, Rule: "type", Param: kind, Value: value})
+}
+
+// formatTime writes an optional time as an RFC 3339 timestamp; nil is written as "".
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// parseTime accepts either an RFC 3339 timestamp or a plain date (YYYY-MM-DD), like the
+// time parameters of the todo list. An empty value is nil.
+func parseTime(field, raw string) (*time.Time, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return &t, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, raw, time.Local); err == nil {
+		return &t, nil
+	}
+	return nil, invalidValue(field, "time", raw)
+}
+
+// splitTags splits a comma separated list of tags and drops empty items.
+func splitTags(raw string) []string {
+	var tags []string
+	for _, tag := range strings.Split(raw, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
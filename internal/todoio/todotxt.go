@@ -0,0 +1,184 @@
+package todoio
+
+import (
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/todotxt"
+)
+
+// A todo.txt export has a task per todo. The project of a todo is written as +project and its
+// tags as @contexts, with whitespace in names replaced by underscores. Priorities map to the
+// letters A (URGENT) to D (LOW). The fields todo.txt has no syntax for are key:value tags, using
+// the keys of the Markdown metadata; the status is only written when the completion mark does
+// not already imply it:
+//
+//	(B) 2026-01-01 Buy milk +Home @groceries due:2026-01-02
+//	x 2026-01-03 2026-01-01 Pay rent +Home pri:A
+//
+// On import, projects are matched by name with the projects of the user; a +project that matches
+// none, and every key:value tag other than status, due, remind and auto_complete, is kept as text
+// in the description.
+
+// todoTxtPriorities maps the priorities of a todo to the priorities of a task.
+var todoTxtPriorities = map[string]string{
+	dto.PriorityUrgent: "A",
+	dto.PriorityHigh:   "B",
+	dto.PriorityMedium: "C",
+	dto.PriorityLow:    "D",
+}
+
+type todoTxtEncoder struct {
+	w       io.Writer
+	options Options
+}
+
+func newTodoTxtEncoder(w io.Writer, options Options) Encoder {
+	return &todoTxtEncoder{w: w, options: options}
+}
+
+func (e *todoTxtEncoder) Encode(todo dto.TodoDTO) error {
+	task := todotxt.Task{
+		Done:         todo.CompletedAt != nil,
+		Priority:     todoTxtPriorities[todo.Priority],
+		CreationDate: todo.CreatedAt.In(time.Local),
+		Text:         todo.Title,
+		Contexts:     todo.Tags,
+	}
+	if todo.CompletedAt != nil {
+		task.CompletionDate = todo.CompletedAt.In(time.Local)
+	}
+	if todo.ProjectID != nil {
+		if name, ok := e.options.Projects[*todo.ProjectID]; ok {
+			task.Projects = []string{name}
+		}
+	}
+	implied := e.options.Defaults.Open
+	if task.Done {
+		implied = e.options.Defaults.Done
+	}
+	if todo.Status != implied {
+		task.Tags = append(task.Tags, todotxt.Tag{Key: "status", Value: todo.Status})
+	}
+	if todo.DueAt != nil {
+		task.Tags = append(task.Tags, todotxt.Tag{Key: "due", Value: todoTxtTime(*todo.DueAt)})
+	}
+	if todo.RemindAt != nil {
+		task.Tags = append(task.Tags, todotxt.Tag{Key: "remind", Value: todoTxtTime(*todo.RemindAt)})
+	}
+	if todo.AutoComplete {
+		task.Tags = append(task.Tags, todotxt.Tag{Key: "auto_complete", Value: "true"})
+	}
+	_, err := io.WriteString(e.w, task.String()+"\n")
+	return err
+}
+
+func (e *todoTxtEncoder) Close() error {
+	return nil
+}
+
+// todoTxtTime writes a time at local midnight as a plain date, like the dates of todo.txt,
+// and any other time as an RFC 3339 timestamp.
+func todoTxtTime(t time.Time) string {
+	t = t.In(time.Local)
+	if t.Equal(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)) {
+		return t.Format(todotxt.DateLayout)
+	}
+	return t.Format(time.RFC3339)
+}
+
+// decodeTodoTxt reads a task per line. Completed tasks get the Done default and open tasks the
+// Open default unless they have a status tag; the creation and completion dates are ignored.
+func decodeTodoTxt(data []byte, options Options) ([]dto.ImportRow, error) {
+	projects := todoTxtProjects(options.Projects)
+	var rows []dto.ImportRow
+	for _, line := range todotxt.ParseFile(data) {
+		form, err := todoTxtForm(line.Task, options.Defaults, projects)
+		rows = append(rows, dto.ImportRow{Line: line.Number, Form: form, Err: err})
+	}
+	return rows, nil
+}
+
+// todoTxtProjects maps the names of projects as written in tasks, in lower case, to their IDs.
+// When names collide the project with the lowest ID wins.
+func todoTxtProjects(names map[int]string) map[string]int {
+	ids := make([]int, 0, len(names))
+	for id := range names {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	projects := make(map[string]int, len(names))
+	for _, id := range ids {
+		word := strings.ToLower(todotxt.Word(names[id]))
+		if _, ok := projects[word]; !ok {
+			projects[word] = id
+		}
+	}
+	return projects
+}
+
+// todoTxtForm converts a task into a todo form.
+func todoTxtForm(task todotxt.Task, defaults Defaults, projects map[string]int) (dto.TodoForm, error) {
+	form := dto.TodoForm{
+		Title:    task.Text,
+		Status:   defaults.Open,
+		Priority: todoTxtPriorityName(task.Priority),
+		Tags:     task.Contexts,
+	}
+	if task.Done {
+		form.Status = defaults.Done
+	}
+
+	// 대응하는 필드가 없는 단어는 버리지 않고 설명에 남깁니다.
+	var description []string
+	for _, project := range task.Projects {
+		if id, ok := projects[strings.ToLower(project)]; ok && form.ProjectID == nil {
+			form.ProjectID = &id
+			continue
+		}
+		description = append(description, "+"+project)
+	}
+	var err error
+	for _, tag := range task.Tags {
+		switch tag.Key {
+		case "status":
+			form.Status = tag.Value
+		case "due":
+			if form.DueAt, err = parseTime("due_at", tag.Value); err != nil {
+				return form, err
+			}
+		case "remind":
+			if form.RemindAt, err = parseTime("remind_at", tag.Value); err != nil {
+				return form, err
+			}
+		case "auto_complete":
+			if form.AutoComplete, err = strconv.ParseBool(tag.Value); err != nil {
+				return form, invalidValue("auto_complete", "bool", tag.Value)
+			}
+		default:
+			description = append(description, tag.Key+":"+tag.Value)
+		}
+	}
+	form.Description = strings.Join(description, " ")
+	return form, nil
+}
+
+// todoTxtPriorityName maps the priority of a task to the priority of a todo. The letters after
+// D are LOW as well; tasks without a priority get the default priority.
+func todoTxtPriorityName(priority string) string {
+	switch {
+	case priority == "":
+		return ""
+	case priority == "A":
+		return dto.PriorityUrgent
+	case priority == "B":
+		return dto.PriorityHigh
+	case priority == "C":
+		return dto.PriorityMedium
+	default:
+		return dto.PriorityLow
+	}
+}
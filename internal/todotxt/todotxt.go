@@ -0,0 +1,188 @@
+// Package todotxt reads and writes tasks in the todo.txt format, one task per line:
+//
+//	x (A) 2026-01-05 2026-01-01 Call mom +Family @phone due:2026-01-06
+//
+// A line starts with an optional completion mark ("x "), priority ("(A) ") and dates, followed
+// by the text of the task. In the text, words starting with + name projects, words starting with @
+// name contexts and key:value words are tags; every other word is part of the description.
+//
+// Completed tasks keep their priority in a pri:A tag, as most todo.txt clients do.
+package todotxt
+
+import (
+	"bytes"
+	"strings"
+	"time"
+)
+
+// DateLayout is the layout of the dates of a task.
+const DateLayout = "2006-01-02"
+
+// Task is a single line of a todo.txt file.
+type Task struct {
+	// Done is set for completed tasks, which start with "x ".
+	Done bool
+	// Priority is the priority from A (highest) to Z, or "" for tasks without a priority.
+	Priority string
+	// CompletionDate is the date a completed task was done; it is zero when not given.
+	CompletionDate time.Time
+	// CreationDate is the date the task was added; it is zero when not given.
+	CreationDate time.Time
+	// Text is the description of the task without its projects, contexts and tags.
+	Text     string
+	Projects []string
+	Contexts []string
+	Tags     []Tag
+}
+
+// Tag is a key:value word of a task.
+type Tag struct {
+	Key   string
+	Value string
+}
+
+// Tag returns the value of the first tag with the given key.
+func (t Task) Tag(key string) (string, bool) {
+	for _, tag := range t.Tags {
+		if tag.Key == key {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// Line is a task read from a file together with the number of its line.
+type Line struct {
+	Number int
+	Task   Task
+}
+
+// ParseFile reads the tasks of a file. Blank lines are skipped; lines may end in CRLF or LF.
+func ParseFile(data []byte) []Line {
+	var lines []Line
+	for i, raw := range bytes.Split(data, []byte("\n")) {
+		text := strings.TrimSpace(string(raw))
+		if text == "" {
+			continue
+		}
+		lines = append(lines, Line{Number: i + 1, Task: Parse(text)})
+	}
+	return lines
+}
+
+// Parse reads a single line. Every line is a valid task: what does not fit the syntax of the
+// completion mark, priority and dates is read as text.
+func Parse(line string) Task {
+	var task Task
+	words := strings.Fields(line)
+
+	if len(words) > 0 && words[0] == "x" {
+		task.Done = true
+		words = words[1:]
+	}
+	if len(words) > 0 && isPriority(words[0]) {
+		task.Priority = words[0][1:2]
+		words = words[1:]
+	}
+	// 완료된 할 일의 첫 날짜는 완료일이고, 두 번째 날짜가 생성일입니다.
+	var dates []time.Time
+	for len(words) > 0 && len(dates) < 2 {
+		date, err := time.ParseInLocation(DateLayout, words[0], time.Local)
+		if err != nil {
+			break
+		}
+		dates = append(dates, date)
+		words = words[1:]
+	}
+	switch {
+	case task.Done && len(dates) == 2:
+		task.CompletionDate, task.CreationDate = dates[0], dates[1]
+	case task.Done && len(dates) == 1:
+		task.CompletionDate = dates[0]
+	case len(dates) > 0:
+		// 미완료 할 일에는 생성일만 있으므로 두 번째 날짜는 본문입니다.
+		task.CreationDate = dates[0]
+		if len(dates) == 2 {
+			words = append([]string{dates[1].Format(DateLayout)}, words...)
+		}
+	}
+
+	var text []string
+	for _, word := range words {
+		switch {
+		case len(word) > 1 && word[0] == '+':
+			task.Projects = append(task.Projects, word[1:])
+		case len(word) > 1 && word[0] == '@':
+			task.Contexts = append(task.Contexts, word[1:])
+		default:
+			if key, value, ok := splitTag(word); ok {
+				if key == "pri" && task.Done && task.Priority == "" && isPriority("("+value+")") {
+					task.Priority = value
+					continue
+				}
+				task.Tags = append(task.Tags, Tag{Key: key, Value: value})
+				continue
+			}
+			text = append(text, word)
+		}
+	}
+	task.Text = strings.Join(text, " ")
+	return task
+}
+
+// String writes the task as a single line. The creation date of a completed task is only
+// written together with its completion date, since a single date after "x " is the completion date.
+func (t Task) String() string {
+	var words []string
+	if t.Done {
+		words = append(words, "x")
+		if !t.CompletionDate.IsZero() {
+			words = append(words, t.CompletionDate.Format(DateLayout))
+			if !t.CreationDate.IsZero() {
+				words = append(words, t.CreationDate.Format(DateLayout))
+			}
+		}
+	} else {
+		if t.Priority != "" {
+			words = append(words, "("+t.Priority+")")
+		}
+		if !t.CreationDate.IsZero() {
+			words = append(words, t.CreationDate.Format(DateLayout))
+		}
+	}
+	if text := strings.Join(strings.Fields(t.Text), " "); text != "" {
+		words = append(words, text)
+	}
+	for _, project := range t.Projects {
+		words = append(words, "+"+Word(project))
+	}
+	for _, context := range t.Contexts {
+		words = append(words, "@"+Word(context))
+	}
+	if t.Done && t.Priority != "" {
+		words = append(words, "pri:"+t.Priority)
+	}
+	for _, tag := range t.Tags {
+		words = append(words, Word(tag.Key)+":"+Word(tag.Value))
+	}
+	return strings.Join(words, " ")
+}
+
+// Word makes a name usable as a single word of a task by replacing its whitespace with underscores,
+// e.g. for a project named "Home office".
+func Word(name string) string {
+	return strings.Join(strings.Fields(name), "_")
+}
+
+func isPriority(word string) bool {
+	return len(word) == 3 && word[0] == '(' && word[1] >= 'A' && word[1] <= 'Z' && word[2] == ')'
+}
+
+// splitTag splits a key:value word. Links such as https://example.com are not tags.
+func splitTag(word string) (string, string, bool) {
+	key, value, ok := strings.Cut(word, ":")
+	if !ok || key == "" || value == "" || strings.HasPrefix(value, "//") {
+		return "", "", false
+	}
+	return key, value, true
+}
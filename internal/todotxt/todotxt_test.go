@@ -0,0 +1,191 @@
+package todotxt
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func date(s string) time.Time {
+	t, err := time.ParseInLocation(DateLayout, s, time.Local)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want Task
+	}{
+		{
+			name: "plain text",
+			line: "Call mom",
+			want: Task{Text: "Call mom"},
+		},
+		{
+			name: "priority and creation date",
+			line: "(A) 2026-01-01 Call mom",
+			want: Task{Priority: "A", CreationDate: date("2026-01-01"), Text: "Call mom"},
+		},
+		{
+			name: "projects, contexts and tags anywhere in the text",
+			line: "Call +Family mom @phone due:2026-01-06 @home",
+			want: Task{
+				Text:     "Call mom",
+				Projects: []string{"Family"},
+				Contexts: []string{"phone", "home"},
+				Tags:     []Tag{{Key: "due", Value: "2026-01-06"}},
+			},
+		},
+		{
+			name: "completed with completion and creation date",
+			line: "x 2026-01-05 2026-01-01 Call mom",
+			want: Task{Done: true, CompletionDate: date("2026-01-05"), CreationDate: date("2026-01-01"), Text: "Call mom"},
+		},
+		{
+			name: "completed with completion date only",
+			line: "x 2026-01-05 Call mom",
+			want: Task{Done: true, CompletionDate: date("2026-01-05"), Text: "Call mom"},
+		},
+		{
+			name: "completed with priority tag",
+			line: "x 2026-01-05 Call mom pri:B",
+			want: Task{Done: true, Priority: "B", CompletionDate: date("2026-01-05"), Text: "Call mom"},
+		},
+		{
+			name: "completed with priority before the dates",
+			line: "x (C) 2026-01-05 Call mom",
+			want: Task{Done: true, Priority: "C", CompletionDate: date("2026-01-05"), Text: "Call mom"},
+		},
+		{
+			name: "pri tag of an open task is an ordinary tag",
+			line: "Call mom pri:B",
+			want: Task{Text: "Call mom", Tags: []Tag{{Key: "pri", Value: "B"}}},
+		},
+		{
+			name: "second date of an open task is text",
+			line: "2026-01-01 2026-02-01 is the deadline",
+			want: Task{CreationDate: date("2026-01-01"), Text: "2026-02-01 is the deadline"},
+		},
+		{
+			name: "uppercase X and lowercase priority are text",
+			line: "X (a) Call mom",
+			want: Task{Text: "X (a) Call mom"},
+		},
+		{
+			name: "priority not at the start is text",
+			line: "Call (A) mom",
+			want: Task{Text: "Call (A) mom"},
+		},
+		{
+			name: "links, lone signs and empty tags are text",
+			line: "Read https://example.com/a:b + @ key: :value",
+			want: Task{Text: "Read https://example.com/a:b + @ key: :value"},
+		},
+		{
+			name: "tag value keeps later colons",
+			line: "Meet remind:2026-01-02T09:00:00Z",
+			want: Task{Text: "Meet", Tags: []Tag{{Key: "remind", Value: "2026-01-02T09:00:00Z"}}},
+		},
+		{
+			name: "invalid date is text",
+			line: "(B) 2026-13-01 Call mom",
+			want: Task{Priority: "B", Text: "2026-13-01 Call mom"},
+		},
+		{
+			name: "extra whitespace",
+			line: "  (A)   Call \t mom  ",
+			want: Task{Priority: "A", Text: "Call mom"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Parse(tt.line); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q) = %+v, want %+v", tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		name string
+		task Task
+		want string
+	}{
+		{
+			name: "open task",
+			task: Task{
+				Priority:     "A",
+				CreationDate: date("2026-01-01"),
+				Text:         "Call mom",
+				Projects:     []string{"Family"},
+				Contexts:     []string{"phone"},
+				Tags:         []Tag{{Key: "due", Value: "2026-01-06"}},
+			},
+			want: "(A) 2026-01-01 Call mom +Family @phone due:2026-01-06",
+		},
+		{
+			name: "completed task keeps its priority in a tag",
+			task: Task{Done: true, Priority: "B", CompletionDate: date("2026-01-05"), CreationDate: date("2026-01-01"), Text: "Call mom"},
+			want: "x 2026-01-05 2026-01-01 Call mom pri:B",
+		},
+		{
+			name: "creation date of a completed task needs a completion date",
+			task: Task{Done: true, CreationDate: date("2026-01-01"), Text: "Call mom"},
+			want: "x Call mom",
+		},
+		{
+			name: "whitespace in text and names",
+			task: Task{Text: "Call\nmom ", Projects: []string{"Home office"}, Contexts: []string{" at  desk"}},
+			want: "Call mom +Home_office @at_desk",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.task.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	lines := []string{
+		"Call mom",
+		"(A) 2026-01-01 Call mom +Family @phone due:2026-01-06",
+		"x 2026-01-05 2026-01-01 Call mom +Family pri:A status:DONE",
+		"x 2026-01-05 Pay rent @home",
+	}
+	for _, line := range lines {
+		if got := Parse(line).String(); got != line {
+			t.Errorf("Parse(%q).String() = %q", line, got)
+		}
+	}
+}
+
+func TestParseFile(t *testing.T) {
+	data := []byte("(A) First\r\n\r\n  \nx Second\nThird")
+	got := ParseFile(data)
+	want := []Line{
+		{Number: 1, Task: Task{Priority: "A", Text: "First"}},
+		{Number: 4, Task: Task{Done: true, Text: "Second"}},
+		{Number: 5, Task: Task{Text: "Third"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseFile() = %+v, want %+v", got, want)
+	}
+}
+
+func TestTag(t *testing.T) {
+	task := Parse("Call mom due:2026-01-06 due:2026-02-01")
+	if value, ok := task.Tag("due"); !ok || value != "2026-01-06" {
+		t.Errorf(`Tag("due") = %q, %v, want "2026-01-06", true`, value, ok)
+	}
+	if _, ok := task.Tag("remind"); ok {
+		t.Error(`Tag("remind") found a tag that is not there`)
+	}
+}
@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/hook"
+	"todo-api-golang/ent/todo"
+	"todo-api-golang/ent/webhook"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/events"
+)
+
+// Register writes a delivery for every todo revision created with the client
+// to each active webhook of the todo's owner subscribed to the event.
+func Register(client *ent.Client) {
+	client.TodoRevision.Use(Hook())
+}
+
+// Hook writes the deliveries of each created revision with the client of the mutation,
+// so that they are committed or rolled back together with the change.
+func Hook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.TodoRevisionFunc(func(ctx context.Context, m *ent.TodoRevisionMutation) (ent.Value, error) {
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return value, err
+			}
+			revision, ok := value.(*ent.TodoRevision)
+			if !ok {
+				return value, nil
+			}
+			client := m.Client()
+
+			owner, err := client.Todo.Query().
+				Where(todo.ID(revision.TodoID)).
+				Select(todo.FieldUserID).
+				Only(ctx)
+			if err != nil {
+				return nil, err
+			}
+			webhooks, err := client.Webhook.Query().
+				Where(webhook.UserID(owner.UserID), webhook.Active(true)).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			event := events.New(owner.UserID, revision)
+			var creates []*ent.WebhookDeliveryCreate
+			var payload []byte
+			for _, w := range webhooks {
+				if !Subscribed(w.Events, event.Type) {
+					continue
+				}
+				if payload == nil {
+					if payload, err = json.Marshal(Payload{TodoEventDTO: dto.ConvertTodoEventToDTO(event), UserID: owner.UserID}); err != nil {
+						return nil, err
+					}
+				}
+				creates = append(creates, client.WebhookDelivery.Create().
+					SetWebhookID(w.ID).
+					SetEventID(event.ID).
+					SetEventType(event.Type).
+					SetPayload(payload).
+					SetNextAttemptAt(time.Now()))
+			}
+			if len(creates) > 0 {
+				if err := client.WebhookDelivery.CreateBulk(creates...).Exec(ctx); err != nil {
+					return nil, err
+				}
+			}
+			return value, nil
+		})
+	}, ent.OpCreate)
+}
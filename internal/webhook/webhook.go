@@ -0,0 +1,105 @@
+// Package webhook delivers the changes of todos to the webhooks registered by their owners.
+//
+// Deliveries are written by an ent hook in the transaction of the change (see Register), so a
+// delivery exists exactly when the change was committed. The webhook worker sends them signed
+// with the secret of the webhook and retries failed attempts with exponential backoff.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"todo-api-golang/internal/dto"
+)
+
+// Headers of a delivery request.
+const (
+	HeaderSignature = "X-Webhook-Signature"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+)
+
+const (
+	// firstRetryDelay is the delay after the first failed attempt; it doubles with every further attempt.
+	firstRetryDelay = 30 * time.Second
+	// maxRetryDelay caps the delay between two attempts.
+	maxRetryDelay = 6 * time.Hour
+)
+
+// ErrInvalidSignature is returned by Verify when the signature does not match the body.
+var ErrInvalidSignature = errors.New("webhook: invalid signature")
+
+// Payload is the JSON body of a delivery: the change feed event of the todo and its owner.
+type Payload struct {
+	dto.TodoEventDTO
+	UserID int `json:"user_id"`
+}
+
+// Sign returns the signature header of a delivery body sent at the given time:
+// "t=<unix time>,v1=<hex HMAC-SHA256 of "<unix time>.<body>" keyed by the secret>".
+// Including the time lets receivers reject replayed deliveries.
+func Sign(secret string, at time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	return "t=" + timestamp + ",v1=" + signature(secret, timestamp, body)
+}
+
+// Verify checks a signature header created by Sign. Signatures older than tolerance are rejected
+// unless tolerance is 0.
+func Verify(secret, header string, body []byte, tolerance time.Duration) error {
+	var timestamp, sig string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			sig = value
+		}
+	}
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || sig == "" {
+		return ErrInvalidSignature
+	}
+	if tolerance > 0 && time.Since(time.Unix(unix, 0)) > tolerance {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(sig), []byte(signature(secret, timestamp, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func signature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Backoff returns the delay before the next attempt after the given number of failed attempts:
+// 30s, 1m, 2m, 4m, ... up to 6h.
+func Backoff(attempts int) time.Duration {
+	delay := firstRetryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// Subscribed reports whether a webhook subscribed to the given event types receives an event.
+// An empty list subscribes to every type.
+func Subscribed(eventTypes []string, eventType string) bool {
+	if len(eventTypes) == 0 {
+		return true
+	}
+	for _, t := range eventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	at := time.Unix(1767225600, 0)
+	body := []byte(`{"type":"todo.updated"}`)
+	header := Sign("secret", at, body)
+
+	if !regexp.MustCompile(`^t=\d+,v1=[0-9a-f]{64}$`).MatchString(header) {
+		t.Fatalf("Sign() = %q, want t=<unix time>,v1=<hex>", header)
+	}
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1767225600." + string(body)))
+	if want := "t=1767225600,v1=" + hex.EncodeToString(mac.Sum(nil)); header != want {
+		t.Errorf("Sign() = %q, want %q", header, want)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"type":"todo.updated"}`)
+	now := time.Now()
+	tests := []struct {
+		name      string
+		secret    string
+		header    string
+		body      []byte
+		tolerance time.Duration
+		wantErr   bool
+	}{
+		{name: "valid", secret: "secret", header: Sign("secret", now, body), body: body, tolerance: time.Minute},
+		{name: "wrong secret", secret: "other", header: Sign("secret", now, body), body: body, wantErr: true},
+		{name: "changed body", secret: "secret", header: Sign("secret", now, body), body: []byte(`{}`), wantErr: true},
+		{name: "changed time", secret: "secret", header: "t=1" + Sign("secret", now, body)[2:], body: body, wantErr: true},
+		{name: "too old", secret: "secret", header: Sign("secret", now.Add(-time.Hour), body), body: body, tolerance: time.Minute, wantErr: true},
+		{name: "old without tolerance", secret: "secret", header: Sign("secret", now.Add(-time.Hour), body), body: body},
+		{name: "missing signature", secret: "secret", header: "t=1767225600", body: body, wantErr: true},
+		{name: "malformed", secret: "secret", header: "garbage", body: body, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.secret, tt.header, tt.body, tt.tolerance)
+			if tt.wantErr && !errors.Is(err, ErrInvalidSignature) {
+				t.Errorf("Verify() = %v, want ErrInvalidSignature", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Verify() = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: 30 * time.Second},
+		{attempts: 1, want: 30 * time.Second},
+		{attempts: 2, want: time.Minute},
+		{attempts: 3, want: 2 * time.Minute},
+		{attempts: 4, want: 4 * time.Minute},
+		{attempts: 10, want: 256 * time.Minute},
+		{attempts: 11, want: 6 * time.Hour},
+		{attempts: 1000, want: 6 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := Backoff(tt.attempts); got != tt.want {
+			t.Errorf("Backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestSubscribed(t *testing.T) {
+	if !Subscribed(nil, "todo.created") {
+		t.Error("an empty list should subscribe to every event type")
+	}
+	if !Subscribed([]string{"todo.created", "todo.deleted"}, "todo.deleted") {
+		t.Error("a listed event type should be subscribed")
+	}
+	if Subscribed([]string{"todo.created"}, "todo.deleted") {
+		t.Error("an event type that is not listed should not be subscribed")
+	}
+}
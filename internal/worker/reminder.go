@@ -0,0 +1,80 @@
+package worker
+
+import (
+	"context"
+	"time"
+	"todo-api-golang/edge/log"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/service"
+)
+
+// reminderBatchSize is the maximum number of reminders claimed per tick.
+const reminderBatchSize = 100
+
+// ReminderNotifier delivers a reminder to the owner of the todo.
+type ReminderNotifier interface {
+	NotifyReminder(ctx context.Context, reminder dto.Reminder) error
+}
+
+// LogNotifier is a ReminderNotifier that only writes the reminder to the log.
+type LogNotifier struct{}
+
+// NotifyReminder logs the reminder.
+func (LogNotifier) NotifyReminder(ctx context.Context, reminder dto.Reminder) error {
+	log.Logger.Info().
+		Int("user_id", reminder.UserID).
+		Int("todo_id", reminder.Todo.ID).
+		Str("title", reminder.Todo.Title).
+		Msg("todo reminder")
+	return nil
+}
+
+// ReminderScheduler periodically sends the reminders whose remind_at time has passed.
+type ReminderScheduler struct {
+	service  service.TodoService
+	notifier ReminderNotifier
+	interval time.Duration
+}
+
+// NewReminderScheduler creates a new ReminderScheduler.
+func NewReminderScheduler(service service.TodoService, notifier ReminderNotifier, interval time.Duration) *ReminderScheduler {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &ReminderScheduler{service: service, notifier: notifier, interval: interval}
+}
+
+// Run sends the due reminders once immediately and then on every interval until ctx is cancelled.
+func (s *ReminderScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatch claims due reminders in batches until none are left.
+func (s *ReminderScheduler) dispatch(ctx context.Context) {
+	for {
+		reminders, err := s.service.ClaimDueReminders(ctx, time.Now(), reminderBatchSize)
+		if err != nil {
+			log.Logger.Error().Err(err).Msg("failed to claim due reminders")
+			return
+		}
+		for _, reminder := range reminders {
+			if err := s.notifier.NotifyReminder(ctx, reminder); err != nil {
+				log.Logger.Error().Err(err).Int("todo_id", reminder.Todo.ID).Msg("failed to send reminder")
+			}
+		}
+		if len(reminders) < reminderBatchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"time"
+	"todo-api-golang/edge/log"
+)
+
+// Purgeable is a service whose soft-deleted entities can be deleted permanently,
+// such as service.TodoService and service.ProjectService.
+type Purgeable interface {
+	PurgeExpiredTrash(ctx context.Context, before time.Time) (int, error)
+}
+
+// TrashPurger periodically deletes entities that have been in the trash longer than the retention period.
+type TrashPurger struct {
+	targets   map[string]Purgeable
+	retention time.Duration
+	interval  time.Duration
+}
+
+// NewTrashPurger creates a new TrashPurger for the given targets, keyed by a name used in the logs.
+func NewTrashPurger(targets map[string]Purgeable, retention, interval time.Duration) *TrashPurger {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &TrashPurger{targets: targets, retention: retention, interval: interval}
+}
+
+// Run purges the trash once immediately and then on every interval until ctx is cancelled.
+// A non-positive retention disables purging.
+func (p *TrashPurger) Run(ctx context.Context) {
+	if p.retention <= 0 {
+		log.Logger.Info().Msg("trash purger disabled")
+		return
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *TrashPurger) purge(ctx context.Context) {
+	before := time.Now().Add(-p.retention)
+	for name, target := range p.targets {
+		purged, err := target.PurgeExpiredTrash(ctx, before)
+		if err != nil {
+			log.Logger.Error().Err(err).Str("target", name).Msg("failed to purge expired trash")
+			continue
+		}
+		if purged > 0 {
+			log.Logger.Info().Str("target", name).Int("purged", purged).Msg("purged expired trash")
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+	"todo-api-golang/edge/log"
+	"todo-api-golang/internal/dto"
+	"todo-api-golang/internal/service"
+	"todo-api-golang/internal/webhook"
+)
+
+const (
+	// webhookBatchSize is the maximum number of deliveries claimed per tick.
+	webhookBatchSize = 50
+	// maxResponseError is how much of a failed response body is kept in the delivery log.
+	maxResponseError = 512
+)
+
+// WebhookDispatcher periodically sends the pending webhook deliveries that are due.
+type WebhookDispatcher struct {
+	service  service.WebhookService
+	client   *http.Client
+	interval time.Duration
+}
+
+// NewWebhookDispatcher creates a new WebhookDispatcher whose requests time out after timeout.
+func NewWebhookDispatcher(service service.WebhookService, timeout, interval time.Duration) *WebhookDispatcher {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	return &WebhookDispatcher{
+		service:  service,
+		client:   &http.Client{Timeout: timeout},
+		interval: interval,
+	}
+}
+
+// Run sends the due deliveries once immediately and then on every interval until ctx is cancelled.
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatch claims due deliveries in batches until none are left.
+func (d *WebhookDispatcher) dispatch(ctx context.Context) {
+	for {
+		deliveries, err := d.service.ClaimDueDeliveries(ctx, time.Now(), webhookBatchSize)
+		if err != nil {
+			log.Logger.Error().Err(err).Msg("failed to claim due webhook deliveries")
+			return
+		}
+		for _, delivery := range deliveries {
+			attempt := d.send(ctx, delivery)
+			if attempt.Error != "" {
+				log.Logger.Warn().
+					Int("delivery_id", delivery.DeliveryID).
+					Str("url", delivery.URL).
+					Str("error", attempt.Error).
+					Msg("webhook delivery failed")
+			}
+			if err := d.service.RecordDeliveryAttempt(ctx, delivery.DeliveryID, attempt); err != nil {
+				log.Logger.Error().Err(err).Int("delivery_id", delivery.DeliveryID).Msg("failed to record webhook delivery attempt")
+			}
+		}
+		if len(deliveries) < webhookBatchSize {
+			return
+		}
+	}
+}
+
+// send posts a signed delivery. Any response other than 2xx counts as a failed attempt.
+func (d *WebhookDispatcher) send(ctx context.Context, delivery dto.WebhookDispatch) dto.WebhookAttempt {
+	now := time.Now()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return dto.WebhookAttempt{Error: err.Error(), At: now}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "todo-api-webhook/1.0")
+	req.Header.Set(webhook.HeaderEvent, delivery.EventType)
+	req.Header.Set(webhook.HeaderDelivery, strconv.Itoa(delivery.DeliveryID))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(delivery.Secret, now, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return dto.WebhookAttempt{Error: err.Error(), At: time.Now()}
+	}
+	defer resp.Body.Close()
+
+	attempt := dto.WebhookAttempt{ResponseStatus: &resp.StatusCode, At: time.Now()}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseError))
+		attempt.Error = fmt.Sprintf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	} else {
+		// 연결을 재사용할 수 있도록 본문을 비웁니다.
+		io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseError))
+	}
+	return attempt
+}
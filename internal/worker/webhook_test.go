@@ -0,0 +1,171 @@
+package worker
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/enttest"
+	"todo-api-golang/ent/webhookdelivery"
+	"todo-api-golang/internal/service"
+	"todo-api-golang/internal/webhook"
+	"todo-api-golang/util"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const (
+	testSecret      = "whsec_test"
+	testMaxAttempts = 3
+)
+
+// receiver is a webhook endpoint that records the requests it receives and answers with status.
+type receiver struct {
+	mu       sync.Mutex
+	status   int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.requests = append(rc.requests, r)
+	rc.bodies = append(rc.bodies, body)
+	w.WriteHeader(rc.status)
+	if rc.status >= 300 {
+		io.WriteString(w, "boom")
+	}
+}
+
+func (rc *receiver) count() int {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return len(rc.requests)
+}
+
+// newWebhookTest creates a webhook pointing at a receiver answering with status and a pending
+// delivery of it, and returns a dispatcher sending through the webhook service.
+func newWebhookTest(t *testing.T, status int) (*WebhookDispatcher, *ent.Client, *receiver, *ent.WebhookDelivery) {
+	ctx := context.Background()
+	rc := &receiver{status: status}
+	server := httptest.NewServer(rc)
+	t.Cleanup(server.Close)
+
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	user := client.User.Create().SetEmail("hooks@example.com").SetDisplayName("hooks").SetPasswordHash("x").SaveX(ctx)
+	hook := client.Webhook.Create().SetURL(server.URL).SetSecret(testSecret).SetUserID(user.ID).SaveX(ctx)
+	delivery := client.WebhookDelivery.Create().
+		SetWebhookID(hook.ID).
+		SetEventID(1).
+		SetEventType("todo.updated").
+		SetPayload([]byte(`{"type":"todo.updated","todo_id":1}`)).
+		SetNextAttemptAt(time.Now()).
+		SaveX(ctx)
+
+	webhookService := service.NewWebhookService(client, util.Config{WebhookMaxAttempts: testMaxAttempts})
+	return NewWebhookDispatcher(webhookService, time.Second, time.Second), client, rc, delivery
+}
+
+// makeDue moves the next attempt of the delivery into the past.
+func makeDue(client *ent.Client, id int) {
+	client.WebhookDelivery.UpdateOneID(id).SetNextAttemptAt(time.Now().Add(-time.Second)).ExecX(context.Background())
+}
+
+func TestWebhookDispatcherSendsSignedDeliveries(t *testing.T) {
+	ctx := context.Background()
+	d, client, rc, delivery := newWebhookTest(t, http.StatusNoContent)
+
+	d.dispatch(ctx)
+
+	if rc.count() != 1 {
+		t.Fatalf("receiver got %d requests, want 1", rc.count())
+	}
+	req, body := rc.requests[0], rc.bodies[0]
+	if string(body) != string(delivery.Payload) {
+		t.Errorf("body = %s, want %s", body, delivery.Payload)
+	}
+	if err := webhook.Verify(testSecret, req.Header.Get(webhook.HeaderSignature), body, time.Minute); err != nil {
+		t.Errorf("signature %q does not verify: %v", req.Header.Get(webhook.HeaderSignature), err)
+	}
+	if got := req.Header.Get(webhook.HeaderEvent); got != "todo.updated" {
+		t.Errorf("%s = %q, want todo.updated", webhook.HeaderEvent, got)
+	}
+	if got := req.Header.Get(webhook.HeaderDelivery); got != strconv.Itoa(delivery.ID) {
+		t.Errorf("%s = %q, want %d", webhook.HeaderDelivery, got, delivery.ID)
+	}
+
+	delivery = client.WebhookDelivery.GetX(ctx, delivery.ID)
+	if delivery.Status != webhookdelivery.StatusSucceeded || delivery.Attempts != 1 || delivery.DeliveredAt == nil || delivery.NextAttemptAt != nil {
+		t.Errorf("delivery = %+v, want succeeded after 1 attempt", delivery)
+	}
+}
+
+func TestWebhookDispatcherRetriesWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	d, client, rc, delivery := newWebhookTest(t, http.StatusInternalServerError)
+
+	for attempt := 1; attempt < testMaxAttempts; attempt++ {
+		before := time.Now()
+		d.dispatch(ctx)
+		after := time.Now()
+
+		delivery = client.WebhookDelivery.GetX(ctx, delivery.ID)
+		if delivery.Status != webhookdelivery.StatusPending || delivery.Attempts != attempt {
+			t.Fatalf("after attempt %d: status %s with %d attempts, want pending", attempt, delivery.Status, delivery.Attempts)
+		}
+		if !strings.Contains(delivery.LastError, "unexpected status 500: boom") {
+			t.Errorf("after attempt %d: last error %q", attempt, delivery.LastError)
+		}
+		if delivery.ResponseStatus == nil || *delivery.ResponseStatus != http.StatusInternalServerError {
+			t.Errorf("after attempt %d: response status %v, want 500", attempt, delivery.ResponseStatus)
+		}
+		backoff := webhook.Backoff(attempt)
+		if next := delivery.NextAttemptAt; next == nil || next.Before(before.Add(backoff)) || next.After(after.Add(backoff)) {
+			t.Errorf("after attempt %d: next attempt at %v, want %v after the attempt", attempt, next, backoff)
+		}
+
+		// 다음 시도 시각 전에는 다시 보내지 않습니다.
+		d.dispatch(ctx)
+		if rc.count() != attempt {
+			t.Fatalf("receiver got %d requests before the retry was due, want %d", rc.count(), attempt)
+		}
+		makeDue(client, delivery.ID)
+	}
+
+	d.dispatch(ctx)
+	delivery = client.WebhookDelivery.GetX(ctx, delivery.ID)
+	if delivery.Status != webhookdelivery.StatusFailed || delivery.Attempts != testMaxAttempts || delivery.NextAttemptAt != nil {
+		t.Errorf("delivery = %+v, want failed after %d attempts", delivery, testMaxAttempts)
+	}
+
+	makeDue(client, delivery.ID)
+	d.dispatch(ctx)
+	if rc.count() != testMaxAttempts {
+		t.Errorf("receiver got %d requests, want no more after the delivery failed", rc.count())
+	}
+}
+
+func TestWebhookDispatcherFailsDeliveriesOfInactiveWebhooks(t *testing.T) {
+	ctx := context.Background()
+	d, client, rc, delivery := newWebhookTest(t, http.StatusNoContent)
+	client.Webhook.UpdateOneID(delivery.WebhookID).SetActive(false).ExecX(ctx)
+
+	d.dispatch(ctx)
+
+	if rc.count() != 0 {
+		t.Errorf("receiver got %d requests, want none for an inactive webhook", rc.count())
+	}
+	delivery = client.WebhookDelivery.GetX(ctx, delivery.ID)
+	if delivery.Status != webhookdelivery.StatusFailed || delivery.Attempts != 0 || delivery.NextAttemptAt != nil {
+		t.Errorf("delivery = %+v, want failed without an attempt", delivery)
+	}
+}
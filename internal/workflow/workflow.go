@@ -0,0 +1,215 @@
+// Package workflow defines the states a todo can be in and the transitions allowed between them.
+//
+// The workflow is configured by a JSON file (see Config) so that states such as BLOCKED or
+// CANCELLED can be added without changing the code. Hooks run on every transition and
+// fill in the fields derived from the status, e.g. started_at and completed_at.
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+	"todo-api-golang/ent"
+	"todo-api-golang/internal/apperror"
+)
+
+// State is a single status of the workflow.
+// Entering a Started state stamps started_at once; entering a Done state stamps completed_at,
+// and leaving the Done states clears it again.
+type State struct {
+	Name    string `json:"name"`
+	Started bool   `json:"started,omitempty"`
+	Done    bool   `json:"done,omitempty"`
+}
+
+// Config is the JSON representation of a workflow.
+// Transitions maps a state to the states it may move to.
+// Complete is the Done state used when a todo is completed automatically by its subtasks.
+type Config struct {
+	States      []State             `json:"states"`
+	Transitions map[string][]string `json:"transitions"`
+	Complete    string              `json:"complete"`
+}
+
+// Transition is a change of the status of a todo.
+// Todo is the todo before the change and is nil while the todo is being created.
+type Transition struct {
+	From string
+	To   string
+	Todo *ent.Todo
+	At   time.Time
+}
+
+// Hook is called with the mutation that applies a transition.
+type Hook func(m *ent.TodoMutation, t Transition)
+
+// Workflow is a validated state machine. It is safe for concurrent use once hooks are registered.
+type Workflow struct {
+	states      []State
+	byName      map[string]State
+	transitions map[string]map[string]bool
+	complete    string
+	hooks       []Hook
+}
+
+// Default returns the built-in workflow, which is used when no workflow file is configured.
+func Default() Config {
+	return Config{
+		States: []State{
+			{Name: "PENDING"},
+			{Name: "PROGRESS", Started: true},
+			{Name: "BLOCKED"},
+			{Name: "COMPLETED", Done: true},
+			{Name: "CANCELLED", Done: true},
+		},
+		Transitions: map[string][]string{
+			"PENDING":   {"PROGRESS", "BLOCKED", "COMPLETED", "CANCELLED"},
+			"PROGRESS":  {"PENDING", "BLOCKED", "COMPLETED", "CANCELLED"},
+			"BLOCKED":   {"PENDING", "PROGRESS", "CANCELLED"},
+			"COMPLETED": {"PENDING", "PROGRESS"},
+			"CANCELLED": {"PENDING"},
+		},
+		Complete: "COMPLETED",
+	}
+}
+
+// Load reads a workflow from a JSON file. An empty path returns the built-in workflow.
+func Load(path string) (*Workflow, error) {
+	if path == "" {
+		return New(Default())
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("workflow %s: %w", path, err)
+	}
+	return New(config)
+}
+
+// New validates the configuration and builds a workflow with the built-in timestamp hook.
+func New(config Config) (*Workflow, error) {
+	if len(config.States) == 0 {
+		return nil, fmt.Errorf("workflow: no states defined")
+	}
+	w := &Workflow{
+		byName:      make(map[string]State, len(config.States)),
+		transitions: make(map[string]map[string]bool, len(config.States)),
+		complete:    config.Complete,
+	}
+	for _, state := range config.States {
+		if state.Name == "" {
+			return nil, fmt.Errorf("workflow: state without a name")
+		}
+		if _, ok := w.byName[state.Name]; ok {
+			return nil, fmt.Errorf("workflow: duplicate state %q", state.Name)
+		}
+		w.states = append(w.states, state)
+		w.byName[state.Name] = state
+		w.transitions[state.Name] = map[string]bool{}
+	}
+	for from, targets := range config.Transitions {
+		if !w.IsState(from) {
+			return nil, fmt.Errorf("workflow: transition from unknown state %q", from)
+		}
+		for _, to := range targets {
+			if !w.IsState(to) {
+				return nil, fmt.Errorf("workflow: transition from %q to unknown state %q", from, to)
+			}
+			w.transitions[from][to] = true
+		}
+	}
+	if !w.byName[w.complete].Done {
+		return nil, fmt.Errorf("workflow: complete state %q must be a done state", w.complete)
+	}
+	w.Use(stampTimes(w))
+	return w, nil
+}
+
+// Use registers a hook that runs on every transition, after the hooks registered before it.
+func (w *Workflow) Use(hook Hook) {
+	w.hooks = append(w.hooks, hook)
+}
+
+// States returns the states in the order they were configured.
+func (w *Workflow) States() []State {
+	return w.states
+}
+
+// IsState reports whether name is a state of the workflow.
+func (w *Workflow) IsState(name string) bool {
+	_, ok := w.byName[name]
+	return ok
+}
+
+// IsDone reports whether name is a done state.
+func (w *Workflow) IsDone(name string) bool {
+	return w.byName[name].Done
+}
+
+// CompleteState returns the state used to complete a todo automatically.
+func (w *Workflow) CompleteState() string {
+	return w.complete
+}
+
+// Targets returns the states a todo in the given state may move to, in the configured order.
+func (w *Workflow) Targets(from string) []string {
+	targets := []string{}
+	for _, state := range w.states {
+		if w.transitions[from][state.Name] {
+			targets = append(targets, state.Name)
+		}
+	}
+	return targets
+}
+
+// CanTransition reports whether a todo may move from one state to the other.
+// Staying in the same state is always allowed, and a todo whose state was removed from the
+// configuration may move to any state so that it is not stuck.
+func (w *Workflow) CanTransition(from, to string) bool {
+	return from == to || !w.IsState(from) || w.transitions[from][to]
+}
+
+// Apply sets the status of the mutation to the given state and runs the hooks.
+// current is the todo before the change, or nil when the todo is being created; a new todo may
+// start in any state. It returns INVALID_TRANSITION when the workflow does not allow the change,
+// and the applied transition otherwise. Nothing but the status is changed when the state stays the same.
+func (w *Workflow) Apply(m *ent.TodoMutation, current *ent.Todo, to string, at time.Time) (Transition, error) {
+	t := Transition{To: to, Todo: current, At: at}
+	if !w.IsState(to) {
+		return t, apperror.Newf(apperror.CodeValidationFailed, "Unknown status %q", to)
+	}
+	if current != nil {
+		t.From = current.Status
+		if !w.CanTransition(t.From, to) {
+			return t, apperror.Newf(apperror.CodeInvalidTransition, "Cannot change the status from %s to %s", t.From, to)
+		}
+	}
+	m.SetStatus(to)
+	if t.From == to {
+		return t, nil
+	}
+	for _, hook := range w.hooks {
+		hook(m, t)
+	}
+	return t, nil
+}
+
+// stampTimes keeps started_at and completed_at in line with the Started and Done states.
+func stampTimes(w *Workflow) Hook {
+	return func(m *ent.TodoMutation, t Transition) {
+		to := w.byName[t.To]
+		if to.Started && (t.Todo == nil || t.Todo.StartedAt == nil) {
+			m.SetStartedAt(t.At)
+		}
+		switch {
+		case to.Done && !w.IsDone(t.From):
+			m.SetCompletedAt(t.At)
+		case !to.Done && t.Todo != nil && t.Todo.CompletedAt != nil:
+			m.ClearCompletedAt()
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"todo-api-golang/edge/token"
+	"todo-api-golang/internal/apperror"
+	response "todo-api-golang/middleware"
+)
+
+// 헤더를 지정할 수 없는 클라이언트가 토큰을 보내는 쿼리 파라미터입니다.
+const (
+	AccessTokenParam = "access_token"
+	FeedTokenParam   = "token"
+)
+
+type contextKey struct{}
+
+var userIDKey = contextKey{}
+
+// Authenticator는 Authorization 헤더의 Bearer 토큰을 검증하고 호출자의 ID를 컨텍스트에 담는 미들웨어입니다.
+func Authenticator(maker token.Maker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			accessToken, ok := bearerToken(r)
+			if !ok {
+				response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Missing bearer token"))
+				return
+			}
+
+			payload, err := maker.VerifyToken(accessToken, token.TypeAccess)
+			if err != nil {
+				if errors.Is(err, token.ErrExpiredToken) {
+					response.ResponseError(w, apperror.New(apperror.CodeTokenExpired, "Access token has expired"))
+				} else {
+					response.ResponseError(w, apperror.New(apperror.CodeInvalidToken, "Invalid access token"))
+				}
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithUserID(r.Context(), payload.UserID)))
+		})
+	}
+}
+
+// QueryToken은 Authorization 헤더가 없을 때 쿼리 파라미터의 토큰을 Bearer 토큰으로 사용하게 하는 미들웨어입니다.
+// 헤더를 지정할 수 없는 EventSource와 브라우저 WebSocket 연결을 위한 것이므로 해당 라우트에서 Authenticator 앞에만 등록합니다.
+func QueryToken(param string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			if value := query.Get(param); value != "" && r.Header.Get("Authorization") == "" {
+				r.Header.Set("Authorization", "Bearer "+value)
+				// 이후의 핸들러가 토큰을 다시 노출하지 않도록 쿼리에서 제거합니다.
+				// 요청 로그는 이 미들웨어보다 먼저 기록되므로 RedactedRequestURI로 가려야 합니다.
+				query.Del(param)
+				r.URL.RawQuery = query.Encode()
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// FeedToken은 쿼리 파라미터의 피드 토큰으로 호출자를 확인하고 그 ID를 컨텍스트에 담는 미들웨어입니다.
+// 헤더를 지정할 수 없는 캘린더 앱의 구독을 위한 것으로, resolve는 토큰이 발급된 사용자의 ID를 돌려줍니다.
+func FeedToken(param string, resolve func(ctx context.Context, token string) (int, error)) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			feedToken := query.Get(param)
+			if feedToken == "" {
+				response.ResponseError(w, apperror.New(apperror.CodeUnauthorized, "Missing feed token"))
+				return
+			}
+			// 이후의 핸들러가 토큰을 다시 노출하지 않도록 쿼리에서 제거합니다.
+			// 요청 로그는 이 미들웨어보다 먼저 기록되므로 RedactedRequestURI로 가려야 합니다.
+			query.Del(param)
+			r.URL.RawQuery = query.Encode()
+
+			userID, err := resolve(r.Context(), feedToken)
+			if err != nil {
+				response.ResponseError(w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithUserID(r.Context(), userID)))
+		})
+	}
+}
+
+// RedactedRequestURI returns the request URI of r with the values of the AccessTokenParam and
+// FeedTokenParam query parameters replaced by REDACTED, so that it can be logged.
+func RedactedRequestURI(r *http.Request) string {
+	query := r.URL.Query()
+	redacted := false
+	for _, param := range []string{AccessTokenParam, FeedTokenParam} {
+		if query.Has(param) {
+			query.Set(param, "REDACTED")
+			redacted = true
+		}
+	}
+	if !redacted {
+		return r.RequestURI
+	}
+	u := *r.URL
+	u.RawQuery = query.Encode()
+	return u.RequestURI()
+}
+
+// WithUserID returns a copy of ctx that carries the authenticated user ID.
+func WithUserID(ctx context.Context, userID int) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// UserIDFromContext returns the authenticated user ID stored by Authenticator.
+func UserIDFromContext(ctx context.Context) (int, bool) {
+	userID, ok := ctx.Value(userIDKey).(int)
+	return userID, ok
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	scheme, value, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	value = strings.TrimSpace(value)
+	return value, value != ""
+}
@@ -0,0 +1,198 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"todo-api-golang/internal/apperror"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// MaxBodyBytes는 BindAndValid가 읽는 요청 본문의 최대 크기입니다.
+var MaxBodyBytes int64 = 1 << 20
+
+// validate는 구조체 정보를 캐시하므로 패키지 전체에서 하나의 인스턴스를 공유합니다.
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	// 에러의 필드 경로를 Go 필드명 대신 JSON 키로 표시합니다.
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return field.Name
+		}
+		return name
+	})
+	return v
+}
+
+// BindAndValid는 요청의 바인딩과 유효성 검사를 수행합니다.
+// 실패하면 *apperror.Error를 반환합니다.
+func BindAndValid(r *http.Request, form interface{}) error {
+	if err := requireJSON(r); err != nil {
+		return err
+	}
+	if err := decodeJSON(http.MaxBytesReader(nil, r.Body, MaxBodyBytes), form); err != nil {
+		return err
+	}
+	return Validate(form)
+}
+
+// DecodeAndValid decodes a JSON document that did not come straight from the request body,
+// e.g. the result of applying a patch, with the same rules as BindAndValid.
+func DecodeAndValid(data []byte, form interface{}) error {
+	if err := decodeJSON(bytes.NewReader(data), form); err != nil {
+		return err
+	}
+	return Validate(form)
+}
+
+// ReadBody checks that the Content-Type of the request is one of mediaTypes and reads the body.
+// It returns the matched media type.
+func ReadBody(r *http.Request, mediaTypes ...string) (string, []byte, error) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || !slices.Contains(mediaTypes, mediaType) {
+		return "", nil, apperror.Newf(apperror.CodeUnsupportedMediaType, "Content-Type must be one of %s", strings.Join(mediaTypes, ", "))
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, MaxBodyBytes))
+	if err != nil {
+		return "", nil, decodeError(err)
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return "", nil, apperror.New(apperror.CodeEmptyBody, "Request body must not be empty")
+	}
+	return mediaType, body, nil
+}
+
+// Validate runs the validator tags of form and converts failures into an *apperror.Error.
+func Validate(form interface{}) error {
+	err := validate.Struct(form)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return apperror.Wrap(err, apperror.CodeInternal, "Failed to validate request body")
+	}
+
+	fields := make([]apperror.FieldError, len(validationErrors))
+	for i, fe := range validationErrors {
+		fields[i] = apperror.FieldError{
+			Field: fieldPath(fe.Namespace()),
+			Rule:  fe.Tag(),
+			Param: fe.Param(),
+			Value: fe.Value(),
+		}
+		// 비밀번호나 비밀 키처럼 sensitive 태그가 붙은 값은 응답에 다시 노출하지 않습니다.
+		if sensitive(reflect.TypeOf(form), fe.StructNamespace()) {
+			fields[i].Value = nil
+		}
+	}
+	return apperror.New(apperror.CodeValidationFailed, "Validation failed").WithFields(fields...)
+}
+
+// RegisterValidation registers a custom validator tag that can be used in form structs.
+func RegisterValidation(tag string, fn validator.Func) error {
+	return validate.RegisterValidation(tag, fn)
+}
+
+func requireJSON(r *http.Request) error {
+	contentType := r.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != "application/json" {
+		return apperror.New(apperror.CodeUnsupportedMediaType, "Content-Type must be application/json")
+	}
+	return nil
+}
+
+func decodeJSON(body io.Reader, form interface{}) error {
+	decoder := json.NewDecoder(body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(form); err != nil {
+		return decodeError(err)
+	}
+	// 본문에 JSON 값이 하나만 있어야 합니다.
+	if err := decoder.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			return decodeError(err)
+		}
+		return apperror.New(apperror.CodeMalformedJSON, "Request body must contain a single JSON value")
+	}
+	return nil
+}
+
+func decodeError(err error) error {
+	var (
+		syntaxError        *json.SyntaxError
+		unmarshalTypeError *json.UnmarshalTypeError
+		maxBytesError      *http.MaxBytesError
+	)
+
+	switch {
+	case errors.Is(err, io.EOF):
+		return apperror.New(apperror.CodeEmptyBody, "Request body must not be empty")
+	case errors.As(err, &maxBytesError):
+		return apperror.Newf(apperror.CodeBodyTooLarge, "Request body must not be larger than %d bytes", maxBytesError.Limit)
+	case errors.As(err, &syntaxError), errors.Is(err, io.ErrUnexpectedEOF):
+		return apperror.Wrap(err, apperror.CodeMalformedJSON, "Malformed JSON in request body")
+	case errors.As(err, &unmarshalTypeError):
+		return apperror.New(apperror.CodeInvalidType, "Request body contains a value of the wrong type").
+			WithFields(apperror.FieldError{
+				Field: unmarshalTypeError.Field,
+				Rule:  "type",
+				Param: unmarshalTypeError.Type.String(),
+				Value: unmarshalTypeError.Value,
+			})
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		// encoding/json은 알 수 없는 필드에 대한 에러 타입을 제공하지 않습니다.
+		field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		return apperror.New(apperror.CodeUnknownField, "Request body contains an unknown field").
+			WithFields(apperror.FieldError{Field: field, Rule: "unknown"})
+	default:
+		return apperror.Wrap(err, apperror.CodeBadRequest, "Failed to decode request body")
+	}
+}
+
+// sensitive reports whether the field at a validator struct namespace, e.g. "UserForm.Password" or
+// "BulkTodoForm.Items[0].Title", is tagged sensitive:"true" in the form type.
+func sensitive(typ reflect.Type, structNamespace string) bool {
+	parts := strings.Split(structNamespace, ".")
+	var field reflect.StructField
+	for _, part := range parts[1:] {
+		for typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			return false
+		}
+		name, _, _ := strings.Cut(part, "[")
+		var ok bool
+		if field, ok = typ.FieldByName(name); !ok {
+			return false
+		}
+		typ = field.Type
+	}
+	return field.Tag.Get("sensitive") == "true"
+}
+
+// fieldPath strips the root struct name from a validator namespace ("TodoForm.status" -> "status").
+func fieldPath(namespace string) string {
+	if _, path, found := strings.Cut(namespace, "."); found {
+		return path
+	}
+	return namespace
+}
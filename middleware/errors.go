@@ -0,0 +1,101 @@
+package response
+
+import (
+	"errors"
+	"net/http"
+	"todo-api-golang/edge/log"
+	"todo-api-golang/ent"
+	"todo-api-golang/internal/apperror"
+)
+
+// codeStatus는 에러 코드와 HTTP 상태 코드의 대응표입니다. 목록에 없는 코드는 500으로 응답합니다.
+var codeStatus = map[apperror.Code]int{
+	apperror.CodeBadRequest:           http.StatusBadRequest,
+	apperror.CodeInvalidParameter:     http.StatusBadRequest,
+	apperror.CodeInvalidQuery:         http.StatusBadRequest,
+	apperror.CodeMalformedJSON:        http.StatusBadRequest,
+	apperror.CodeUnknownField:         http.StatusBadRequest,
+	apperror.CodeInvalidType:          http.StatusBadRequest,
+	apperror.CodeEmptyBody:            http.StatusBadRequest,
+	apperror.CodeBodyTooLarge:         http.StatusRequestEntityTooLarge,
+	apperror.CodeUnsupportedMediaType: http.StatusUnsupportedMediaType,
+	apperror.CodeValidationFailed:     http.StatusUnprocessableEntity,
+	apperror.CodeSubtaskDepthExceeded: http.StatusUnprocessableEntity,
+	apperror.CodeSubtaskCycle:         http.StatusUnprocessableEntity,
+	apperror.CodeInvalidPatch:         http.StatusUnprocessableEntity,
+	apperror.CodeBulkLimitExceeded:    http.StatusUnprocessableEntity,
+	apperror.CodeIdempotencyKeyReused: http.StatusUnprocessableEntity,
+	apperror.CodeImportLimitExceeded:  http.StatusUnprocessableEntity,
+
+	apperror.CodeUnauthorized:        http.StatusUnauthorized,
+	apperror.CodeTokenExpired:        http.StatusUnauthorized,
+	apperror.CodeInvalidToken:        http.StatusUnauthorized,
+	apperror.CodeInvalidCredentials:  http.StatusUnauthorized,
+	apperror.CodeInvalidRefreshToken: http.StatusUnauthorized,
+
+	apperror.CodeNotFound:         http.StatusNotFound,
+	apperror.CodeRouteNotFound:    http.StatusNotFound,
+	apperror.CodeMethodNotAllowed: http.StatusMethodNotAllowed,
+	apperror.CodeTodoNotFound:     http.StatusNotFound,
+	apperror.CodeUserNotFound:     http.StatusNotFound,
+	apperror.CodeProjectNotFound:  http.StatusNotFound,
+	apperror.CodeTagNotFound:      http.StatusNotFound,
+	apperror.CodeRevisionNotFound: http.StatusNotFound,
+	apperror.CodeWebhookNotFound:  http.StatusNotFound,
+	apperror.CodeDeliveryNotFound: http.StatusNotFound,
+
+	apperror.CodeConflict:          http.StatusConflict,
+	apperror.CodeEmailTaken:        http.StatusConflict,
+	apperror.CodeProjectArchived:   http.StatusConflict,
+	apperror.CodeTagNameTaken:      http.StatusConflict,
+	apperror.CodeInvalidTransition: http.StatusConflict,
+	apperror.CodeRequestInProgress: http.StatusConflict,
+
+	apperror.CodePreconditionFailed: http.StatusPreconditionFailed,
+
+	apperror.CodeInternal: http.StatusInternalServerError,
+}
+
+// StatusOf returns the HTTP status for an error code.
+func StatusOf(code apperror.Code) int {
+	if status, ok := codeStatus[code]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// ResponseError는 서비스/ent 에러를 HTTP 상태 코드와 에러 코드로 변환해 응답합니다.
+func ResponseError(w http.ResponseWriter, err error) {
+	appErr := AppError(err)
+	status := StatusOf(appErr.Code)
+	if status >= http.StatusInternalServerError {
+		log.Logger.Error().Err(err).Str("code", string(appErr.Code)).Msg("request failed")
+	}
+
+	writeJSON(w, status, Response{
+		Code:      status,
+		Msg:       appErr.Message,
+		ErrorCode: appErr.Code,
+		Errors:    appErr.Fields,
+	})
+}
+
+// AppError converts any error into an *apperror.Error.
+// Errors that are not already typed are mapped from their ent error kind.
+func AppError(err error) *apperror.Error {
+	var appErr *apperror.Error
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+
+	switch {
+	case ent.IsNotFound(err):
+		return apperror.Wrap(err, apperror.CodeNotFound, "Resource not found")
+	case ent.IsConstraintError(err):
+		return apperror.Wrap(err, apperror.CodeConflict, "Request conflicts with the current state of the resource")
+	case ent.IsValidationError(err):
+		return apperror.Wrap(err, apperror.CodeValidationFailed, "Validation failed")
+	default:
+		return apperror.Wrap(err, apperror.CodeInternal, "Internal server error")
+	}
+}
@@ -0,0 +1,152 @@
+// Package idempotency makes POST requests safe to retry with the Idempotency-Key header.
+//
+// The first request with a key is handled normally and its response is stored for a TTL.
+// Repeats of the request with the same key replay the stored response instead of running
+// the handler again, and reusing the key for a different request fails with 422.
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+	"todo-api-golang/internal/apperror"
+	response "todo-api-golang/middleware"
+	"todo-api-golang/middleware/auth"
+
+	gochi_middleware "github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	// HeaderKey is the request header carrying the idempotency key.
+	HeaderKey = "Idempotency-Key"
+	// HeaderReplayed is set on responses replayed from the store.
+	HeaderReplayed = "Idempotent-Replayed"
+	// MaxKeyLength is the maximum length of an idempotency key.
+	MaxKeyLength = 255
+	// DefaultTTL is used when no TTL is configured.
+	DefaultTTL = 24 * time.Hour
+)
+
+// storedHeaders are the response headers that are stored and replayed with the body.
+var storedHeaders = []string{"Content-Type", "ETag", "Location", "Link"}
+
+// Record is a stored request. StatusCode is 0 while the first request is still being handled.
+type Record struct {
+	RequestHash string
+	StatusCode  int
+	Header      map[string]string
+	Body        []byte
+}
+
+// Store keeps the idempotency keys. Keys are scoped by user; userID is 0 for unauthenticated requests.
+type Store interface {
+	// Reserve stores an in-progress record for the key. When an unexpired record already exists
+	// it is returned instead and nothing is stored.
+	Reserve(ctx context.Context, userID int, key, requestHash string, expiresAt time.Time) (*Record, error)
+	// Complete stores the response of the request the key was reserved for.
+	Complete(ctx context.Context, userID int, key string, record Record) error
+	// Release deletes the key so that the request can be sent again.
+	Release(ctx context.Context, userID int, key string) error
+}
+
+// Middleware는 Idempotency-Key 헤더가 있는 POST 요청의 응답을 ttl 동안 저장하고 재시도 시 그대로 재전송하는 미들웨어입니다.
+// 사용자별로 키를 구분하므로 auth.Authenticator 뒤에 등록해야 합니다.
+// 5xx 응답은 저장하지 않아 같은 키로 다시 시도할 수 있습니다.
+func Middleware(store Store, ttl time.Duration) func(http.Handler) http.Handler {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(HeaderKey)
+			if r.Method != http.MethodPost || key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > MaxKeyLength {
+				response.ResponseError(w, apperror.Newf(apperror.CodeBadRequest, "%s must not be longer than %d characters", HeaderKey, MaxKeyLength))
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, response.MaxBodyBytes))
+			if err != nil {
+				response.ResponseError(w, apperror.Newf(apperror.CodeBodyTooLarge, "Request body must not be larger than %d bytes", response.MaxBodyBytes))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			hash := requestHash(r, body)
+
+			userID, _ := auth.UserIDFromContext(r.Context())
+			stored, err := store.Reserve(r.Context(), userID, key, hash, time.Now().Add(ttl))
+			if err != nil {
+				response.ResponseError(w, err)
+				return
+			}
+			if stored != nil {
+				replay(w, stored, hash)
+				return
+			}
+
+			// 핸들러가 패닉을 일으키면 키를 해제해 재시도할 수 있게 합니다.
+			completed := false
+			defer func() {
+				if !completed {
+					store.Release(context.WithoutCancel(r.Context()), userID, key)
+				}
+			}()
+
+			var buf bytes.Buffer
+			ww := gochi_middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(&buf)
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			if status >= http.StatusInternalServerError {
+				return
+			}
+			record := Record{RequestHash: hash, StatusCode: status, Header: map[string]string{}, Body: buf.Bytes()}
+			for _, name := range storedHeaders {
+				if value := w.Header().Get(name); value != "" {
+					record.Header[name] = value
+				}
+			}
+			// 응답은 이미 전송되었으므로 저장에 실패해도 요청을 실패로 처리하지 않습니다.
+			if err := store.Complete(context.WithoutCancel(r.Context()), userID, key, record); err == nil {
+				completed = true
+			}
+		})
+	}
+}
+
+// replay writes the stored response, or an error when the key was used for another request
+// or the first request has not finished yet.
+func replay(w http.ResponseWriter, stored *Record, hash string) {
+	switch {
+	case stored.RequestHash != hash:
+		response.ResponseError(w, apperror.Newf(apperror.CodeIdempotencyKeyReused, "%s was already used for a different request", HeaderKey))
+	case stored.StatusCode == 0:
+		response.ResponseError(w, apperror.Newf(apperror.CodeRequestInProgress, "A request with this %s is still being processed", HeaderKey))
+	default:
+		for name, value := range stored.Header {
+			w.Header().Set(name, value)
+		}
+		w.Header().Set(HeaderReplayed, "true")
+		w.WriteHeader(stored.StatusCode)
+		w.Write(stored.Body)
+	}
+}
+
+// requestHash identifies a request by its method, path, query and body.
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	io.WriteString(h, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery+"\n")
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
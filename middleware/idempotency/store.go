@@ -0,0 +1,74 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+	"todo-api-golang/ent"
+	"todo-api-golang/ent/idempotencykey"
+)
+
+// entStore keeps the idempotency keys in the idempotency_keys table.
+type entStore struct {
+	client *ent.Client
+}
+
+// NewEntStore creates a Store backed by the database.
+func NewEntStore(client *ent.Client) Store {
+	return &entStore{client: client}
+}
+
+func (s *entStore) Reserve(ctx context.Context, userID int, key, requestHash string, expiresAt time.Time) (*Record, error) {
+	// 만료된 키는 예약할 때 함께 정리합니다.
+	_, err := s.client.IdempotencyKey.Delete().
+		Where(idempotencykey.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.client.IdempotencyKey.Create().
+		SetUserID(userID).
+		SetKey(key).
+		SetRequestHash(requestHash).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+	if err == nil {
+		return nil, nil
+	}
+	if !ent.IsConstraintError(err) {
+		return nil, err
+	}
+
+	// 같은 키가 이미 있으면 저장된 요청을 돌려줍니다.
+	stored, err := s.client.IdempotencyKey.Query().
+		Where(idempotencykey.UserID(userID), idempotencykey.Key(key)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	record := &Record{
+		RequestHash: stored.RequestHash,
+		Header:      stored.Header,
+		Body:        stored.Body,
+	}
+	if stored.StatusCode != nil {
+		record.StatusCode = *stored.StatusCode
+	}
+	return record, nil
+}
+
+func (s *entStore) Complete(ctx context.Context, userID int, key string, record Record) error {
+	return s.client.IdempotencyKey.Update().
+		Where(idempotencykey.UserID(userID), idempotencykey.Key(key)).
+		SetStatusCode(record.StatusCode).
+		SetHeader(record.Header).
+		SetBody(record.Body).
+		Exec(ctx)
+}
+
+func (s *entStore) Release(ctx context.Context, userID int, key string) error {
+	_, err := s.client.IdempotencyKey.Delete().
+		Where(idempotencykey.UserID(userID), idempotencykey.Key(key)).
+		Exec(ctx)
+	return err
+}
@@ -2,45 +2,31 @@ package response
 
 import (
 	"encoding/json"
-	"github.com/go-playground/validator/v10"
-	"log"
 	"net/http"
+	"todo-api-golang/internal/apperror"
 )
 
 // Response 구조체는 HTTP 응답을 구조화합니다.
+// 에러 응답에는 ErrorCode와 필드별 에러 목록(Errors)이 포함됩니다.
 type Response struct {
-	Code int         `json:"code"`
-	Msg  string      `json:"msg"`
-	Data interface{} `json:"data"`
+	Code      int                   `json:"code"`
+	Msg       string                `json:"msg"`
+	Data      interface{}           `json:"data"`
+	ErrorCode apperror.Code         `json:"error_code,omitempty" swaggertype:"string"`
+	Errors    []apperror.FieldError `json:"errors,omitempty"`
 }
 
 // ResponseJSON은 HTTP 응답을 JSON 형식으로 반환합니다.
 func ResponseJSON(w http.ResponseWriter, httpCode, errCode int, msg string, data interface{}) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(httpCode)
-	json.NewEncoder(w).Encode(Response{
+	writeJSON(w, httpCode, Response{
 		Code: errCode,
 		Msg:  msg,
 		Data: data,
 	})
 }
 
-// BindAndValid는 요청의 바인딩과 유효성 검사를 수행합니다.
-func BindAndValid(r *http.Request, form interface{}) (int, int) {
-	// 요청 본문을 바인딩
-	err := json.NewDecoder(r.Body).Decode(form)
-	if err != nil {
-		log.Fatalf("Failed to decode the request body: %v", err)
-		return http.StatusBadRequest, 400
-	}
-
-	// 데이터 유효성 검사
-	validate := validator.New()
-	err = validate.Struct(form)
-	if err != nil {
-		log.Fatalf("Failed to decode the request body: %v", err)
-		return http.StatusBadRequest, 400
-	}
-
-	return http.StatusOK, 200
+func writeJSON(w http.ResponseWriter, httpCode int, body Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpCode)
+	json.NewEncoder(w).Encode(body)
 }
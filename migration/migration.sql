@@ -1,11 +1,179 @@
+create table users
+(
+    id                  INT AUTO_INCREMENT PRIMARY KEY,
+    email               VARCHAR(255) NOT NULL UNIQUE,
+    display_name        VARCHAR(255) NOT NULL,
+    password_hash       VARCHAR(255) NOT NULL,
+    calendar_token_hash VARCHAR(255) UNIQUE,
+    created_at          DATETIME     NOT NULL,
+    updated_at          DATETIME     NOT NULL
+) CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci;
+
+
+create table projects
+(
+    id         INT AUTO_INCREMENT PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    color      VARCHAR(255),
+    archived   BOOLEAN      NOT NULL DEFAULT FALSE,
+    deleted_at DATETIME,
+    user_id    INT          NOT NULL,
+    created_at DATETIME     NOT NULL,
+    updated_at DATETIME     NOT NULL,
+    CONSTRAINT projects_users_projects FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci;
+
+
 create table todos
 (
     id          INT AUTO_INCREMENT PRIMARY KEY,
     title       VARCHAR(255)                              NOT NULL,
     description TEXT,
-    status      enum ('PENDING', 'COMPLETED', 'PROGRESS') NOT NULL,
+    status      VARCHAR(255)                              NOT NULL DEFAULT 'PENDING',
+    started_at  DATETIME,
+    completed_at DATETIME,
+    due_at      DATETIME,
+    remind_at   DATETIME,
+    reminded_at DATETIME,
+    priority    INT                                       NOT NULL DEFAULT 1,
+    position    VARCHAR(255)                              NOT NULL DEFAULT 'a0',
+    auto_complete BOOLEAN                                 NOT NULL DEFAULT FALSE,
+    version     INT                                       NOT NULL DEFAULT 1,
+    uid         VARCHAR(255),
+    user_id     INT                                       NOT NULL,
+    project_id  INT,
+    parent_id   INT,
     created_at  DATETIME                                  NOT NULL,
     updated_at  DATETIME                                  NOT NULL,
-    deleted_at  DATETIME
+    deleted_at  DATETIME,
+    INDEX todo_user_id_due_at (user_id, due_at),
+    INDEX todo_remind_at_reminded_at (remind_at, reminded_at),
+    INDEX todo_user_id_position (user_id, position),
+    UNIQUE INDEX todo_user_id_uid (user_id, uid),
+    CONSTRAINT todos_users_todos FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT todos_projects_todos FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE SET NULL,
+    CONSTRAINT todos_todos_children FOREIGN KEY (parent_id) REFERENCES todos (id) ON DELETE CASCADE
+) CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci;
+
+
+create table todo_transitions
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    todo_id     INT          NOT NULL,
+    from_status VARCHAR(255),
+    to_status   VARCHAR(255) NOT NULL,
+    created_at  DATETIME     NOT NULL,
+    INDEX todotransition_todo_id_created_at (todo_id, created_at),
+    CONSTRAINT todo_transitions_todos_transitions FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+) CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci;
+
+
+create table todo_revisions
+(
+    id         INT AUTO_INCREMENT PRIMARY KEY,
+    todo_id    INT                                                    NOT NULL,
+    user_id    INT,
+    action     enum ('create', 'update', 'status', 'delete', 'restore') NOT NULL,
+    changes    JSON                                                   NOT NULL,
+    snapshot   JSON                                                   NOT NULL,
+    created_at DATETIME                                               NOT NULL,
+    INDEX todorevision_todo_id_created_at (todo_id, created_at),
+    CONSTRAINT todo_revisions_todos_revisions FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+) CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci;
+
+
+create table tags
+(
+    id         INT AUTO_INCREMENT PRIMARY KEY,
+    name       VARCHAR(50) NOT NULL,
+    user_id    INT         NOT NULL,
+    created_at DATETIME    NOT NULL,
+    updated_at DATETIME    NOT NULL,
+    UNIQUE INDEX tag_user_id_name (user_id, name),
+    CONSTRAINT tags_users_tags FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci;
+
+
+create table tag_todos
+(
+    tag_id  INT NOT NULL,
+    todo_id INT NOT NULL,
+    PRIMARY KEY (tag_id, todo_id),
+    CONSTRAINT tag_todos_tag_id FOREIGN KEY (tag_id) REFERENCES tags (id) ON DELETE CASCADE,
+    CONSTRAINT tag_todos_todo_id FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+) CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci;
+
+
+create table sessions
+(
+    id         CHAR(36)     NOT NULL PRIMARY KEY,
+    user_id    INT          NOT NULL,
+    user_agent TEXT,
+    client_ip  VARCHAR(255),
+    expires_at DATETIME     NOT NULL,
+    revoked_at DATETIME,
+    created_at DATETIME     NOT NULL,
+    updated_at DATETIME     NOT NULL,
+    CONSTRAINT sessions_users_sessions FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci;
+
+
+create table idempotency_keys
+(
+    id           INT AUTO_INCREMENT PRIMARY KEY,
+    user_id      INT          NOT NULL DEFAULT 0,
+    `key`        VARCHAR(255) NOT NULL,
+    request_hash VARCHAR(255) NOT NULL,
+    status_code  INT,
+    header       JSON,
+    body         BLOB,
+    created_at   DATETIME     NOT NULL,
+    expires_at   DATETIME     NOT NULL,
+    UNIQUE INDEX idempotencykey_user_id_key (user_id, `key`),
+    INDEX idempotencykey_expires_at (expires_at)
+) CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci;
+
+
+create table webhooks
+(
+    id         INT AUTO_INCREMENT PRIMARY KEY,
+    url        VARCHAR(2048) NOT NULL,
+    secret     VARCHAR(255)  NOT NULL,
+    events     JSON,
+    active     BOOLEAN       NOT NULL DEFAULT TRUE,
+    user_id    INT           NOT NULL,
+    created_at DATETIME      NOT NULL,
+    updated_at DATETIME      NOT NULL,
+    CONSTRAINT webhooks_users_webhooks FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) CHARACTER SET utf8mb4
+  COLLATE utf8mb4_unicode_ci;
+
+
+create table webhook_deliveries
+(
+    id              INT AUTO_INCREMENT PRIMARY KEY,
+    webhook_id      INT                                     NOT NULL,
+    event_id        INT                                     NOT NULL,
+    event_type      VARCHAR(255)                            NOT NULL,
+    payload         BLOB                                    NOT NULL,
+    status          enum ('pending', 'succeeded', 'failed') NOT NULL DEFAULT 'pending',
+    attempts        INT                                     NOT NULL DEFAULT 0,
+    next_attempt_at DATETIME,
+    response_status INT,
+    last_error      LONGTEXT,
+    delivered_at    DATETIME,
+    created_at      DATETIME                                NOT NULL,
+    INDEX webhookdelivery_status_next_attempt_at (status, next_attempt_at),
+    INDEX webhookdelivery_webhook_id_created_at (webhook_id, created_at),
+    CONSTRAINT webhook_deliveries_webhooks_deliveries FOREIGN KEY (webhook_id) REFERENCES webhooks (id) ON DELETE CASCADE
 ) CHARACTER SET utf8mb4
-  COLLATE utf8mb4_unicode_ci;
\ No newline at end of file
+  COLLATE utf8mb4_unicode_ci;
@@ -8,10 +8,21 @@ import (
 type Config struct {
 	RDB                    string        `mapstructure:"rdb"`
 	PORT                   string        `mapstructure:"port"`
-	Access_Token_Duration  time.Duration `mapstructure:"accessTokenDuration"`
-	Refresh_Token_Duration time.Duration `mapstructure:"refreshTokenDuration"`
-	SecretKeyHex           string        `mapstructure:"secretKeyHex"`
-	PublicKeyHex           string        `mapstructure:"publicKeyHex"`
+	GRPCPort               string        `mapstructure:"grpc_port"`
+	Environment            string        `mapstructure:"environment"`
+	Access_Token_Duration  time.Duration `mapstructure:"access_token_duration"`
+	Refresh_Token_Duration time.Duration `mapstructure:"refresh_token_duration"`
+	SecretKeyHex           string        `mapstructure:"secret_key_hex"`
+	PublicKeyHex           string        `mapstructure:"public_key_hex"`
+	TrashRetention         time.Duration `mapstructure:"trash_retention"`
+	TrashPurgeInterval     time.Duration `mapstructure:"trash_purge_interval"`
+	ReminderInterval       time.Duration `mapstructure:"reminder_interval"`
+	WorkflowFile           string        `mapstructure:"workflow_file"`
+	BulkMaxItems           int           `mapstructure:"bulk_max_items"`
+	IdempotencyTTL         time.Duration `mapstructure:"idempotency_ttl"`
+	WebhookInterval        time.Duration `mapstructure:"webhook_interval"`
+	WebhookTimeout         time.Duration `mapstructure:"webhook_timeout"`
+	WebhookMaxAttempts     int           `mapstructure:"webhook_max_attempts"`
 }
 
 func LoadConfig(path string) (config Config, err error) {